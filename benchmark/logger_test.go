@@ -71,6 +71,17 @@ func BenchmarkLogger(b *testing.B) {
 				event.New().Prefix(prefix).Sub(sub).Level(event.Level_warn).Message(msg).Metadata(meta).Build()
 			}
 		})
+		b.Run("NewComplexEventWithAttrs", func(b *testing.B) {
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				event.New().Prefix(prefix).Sub(sub).Level(event.Level_warn).Message(msg).Attrs(
+					event.Bool("complex", true),
+					event.Int("id", 1234567890),
+					event.Object("content", event.Bool("data", true)),
+					event.Strings("affected", "none", "nothing", "nada"),
+				).Build()
+			}
+		})
 		b.Run("NewComplexEventWithCallStack", func(b *testing.B) {
 			b.ResetTimer()
 			for n := 0; n < b.N; n++ {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync/atomic"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -68,10 +69,24 @@ type GRPCLogClient struct {
 
 	svcLogger log.Logger
 	backoff   *Backoff
+	hooks     log.Hooks
 
 	prefix string
 	sub    string
 	meta   map[string]interface{}
+
+	// closed is shared with the client's children, and set to 1 once it is closed
+	closed *int32
+
+	// closing is shared with the client's children, and closed once the client is closed; to
+	// release the writes waiting on the message channel
+	closing chan struct{}
+
+	// pending is shared with the client's children, tracking the events in flight
+	pending *pending
+
+	// metrics is shared with the client's children
+	metrics *clientMetrics
 }
 
 // GRPCLogClientBuilder struct is an entrypoint object to create a GRPCLogClient
@@ -85,6 +100,7 @@ type gRPCLogClientBuilder struct {
 	isUnary      bool
 	backoff      *Backoff
 	svcLogger    log.Logger
+	hooks        log.Hooks
 }
 
 // clientInterceptors struct is a placeholder for different interceptors to be added
@@ -127,9 +143,14 @@ func (b *gRPCLogClientBuilder) build() *GRPCLogClient {
 		errCh:     make(chan error),
 		svcLogger: b.svcLogger,
 		backoff:   b.backoff,
+		hooks:     b.hooks,
 		prefix:    "log",
 		sub:       "",
 		meta:      map[string]interface{}{},
+		closed:    new(int32),
+		closing:   make(chan struct{}),
+		pending:   newPending(),
+		metrics:   &clientMetrics{},
 	}
 
 	client.backoff.init(b, client)
@@ -269,7 +290,12 @@ func (c *GRPCLogClient) listen() {
 		select {
 		case msg := <-c.msgCh:
 			c.backoff.AddMessage(msg)
-			go c.log(msg)
+
+			go func(msg *event.Event) {
+				defer c.pending.done(msg)
+
+				c.log(msg)
+			}(msg)
 		case <-c.done:
 			return
 		}
@@ -287,13 +313,15 @@ func (c *GRPCLogClient) log(msg *event.Event) {
 	if err != nil {
 
 		// check if errors are failed connection or backoff locked errors;
-		// return so the action is canceLevel_ed
+		// return so the action is canceLevel_ed (and retried by the backoff module)
 		if errors.Is(err, ErrFailedConn) || errors.Is(err, ErrBackoffLocked) {
+			c.metrics.add(retriedCounter)
 			return
 		}
 
 		// any other errors will be sent to the error channel and logged locally;
 		// then canceLevel_ing this action
+		c.metrics.add(droppedCounter)
 		c.errCh <- err
 
 		c.svcLogger.Log(event.New().Level(event.Level_fatal).Prefix("gRPC").Sub("log").Metadata(event.Field{
@@ -328,12 +356,14 @@ func (c *GRPCLogClient) log(msg *event.Event) {
 		// if the server returns any error, it's sent to the error channel, context cancelled,
 		// and then return
 		if err != nil {
+			c.metrics.add(droppedCounter)
 			c.errCh <- err
 			cancel()
 			return
 		}
 
 		// message sent; response retrieved; context is cancelled.
+		c.metrics.add(sentCounter)
 		cancel()
 	}
 }
@@ -392,6 +422,7 @@ func (c *GRPCLogClient) stream() {
 
 			// if it's connection refused or EOF, kick-off the backoff routine
 			if errCode := status.Code(err); errCode == codes.Unavailable || errors.Is(err, io.EOF) {
+				c.metrics.add(retriedCounter)
 				c.backoff.StreamBackoffHandler(c.errCh, cancel, c.svcLogger, c.done)
 			}
 
@@ -517,11 +548,16 @@ func (c *GRPCLogClient) handleStreamMessages(
 
 			// send the protofied message and check for errors (sinked to local error channel)
 			err := stream.Send(out)
+			c.pending.done(out)
 
 			if err != nil {
+				c.metrics.add(droppedCounter)
 				localErr <- err
+				continue
 			}
 
+			c.metrics.add(sentCounter)
+
 		// done is received -- gracefully exit by canceLevel_ing context and closing the connection
 		case <-c.done:
 			cancel()
@@ -549,6 +585,7 @@ func (c *GRPCLogClient) handleStreamMessages(
 				// Connection Refused or EOF error -- trigger backoff routine
 			} else if errCode := status.Code(err); errCode == codes.Unavailable || errors.Is(err, io.EOF) {
 
+				c.metrics.add(retriedCounter)
 				c.backoff.StreamBackoffHandler(c.errCh, cancel, c.svcLogger, c.done)
 
 				// Bad Response -- send to error channel, continue
@@ -573,36 +610,60 @@ func (c *GRPCLogClient) handleStreamMessages(
 	}
 }
 
-// Close method is the implementation of the ChanneledLogger's Close().
+// Close method is the implementation of the ChanneledLogger's and Logger's Close().
 //
-// For a gRPC Log Client, it is important to iterate through all (alive)
-// connections in the ConnAddr map, and close them. After doing so, it
-// sends the done signal to its channel, which causes all open streams to
-// cancel their context and exit gracefully
-func (c *GRPCLogClient) Close() {
+// For a gRPC Log Client, it first waits for the events in flight to be sent (as
+// in Sync()), and then iterates through all (alive) connections in the ConnAddr
+// map, and closes them. After doing so, it sends the done signal to its channel,
+// which causes all open streams to cancel their context and exit gracefully. The
+// first unexpected error when closing a connection is returned.
+//
+// Closing a gRPC Log Client (or any of its children) rejects further writes
+// with log.ErrClosed, including the ones waiting on the message channel; and
+// closing it more than once is a no-op
+func (c *GRPCLogClient) Close() error {
+	if c.closed != nil && !atomic.CompareAndSwapInt32(c.closed, 0, 1) {
+		return nil
+	}
+
+	if c.closing != nil {
+		close(c.closing)
+	}
+
+	c.pending.wait()
+
+	var err error
+
 	for _, conn := range c.addr.AsMap() {
 		if conn == nil {
 			continue
 		}
 
-		err := conn.Close()
+		cerr := conn.Close()
 
-		if err != nil {
-			if errStatus, ok := status.FromError(err); ok {
-				switch errStatus.Code() {
-				case codes.Canceled:
-					continue
-				default:
-					c.errCh <- err
-					return
-				}
-			}
-			c.errCh <- err
-			return
+		if cerr != nil && status.Code(cerr) != codes.Canceled && err == nil {
+			err = cerr
 		}
-
 	}
+
 	c.done <- struct{}{}
+	return err
+}
+
+// Sync method implements the Logger's Sync().
+//
+// It waits for the events written with Output() (and the methods built on it) to be
+// taken from the message channel and sent to the gRPC Log Server(s), or dropped. Events
+// which fail to be sent and are deferred to the backoff module are not waited for
+func (c *GRPCLogClient) Sync() error {
+	c.pending.wait()
+
+	return nil
+}
+
+// isClosed method returns true if the gRPC Log Client is closed
+func (c *GRPCLogClient) isClosed() bool {
+	return c.closed != nil && atomic.LoadInt32(c.closed) == 1
 }
 
 // Channels method is the implementation of the ChanneledLogger's Channels().
@@ -619,9 +680,31 @@ func (c *GRPCLogClient) Channels() (logCh chan *event.Event, done chan struct{})
 //
 // This method will simply push the incoming Log Message to the message channel,
 // which is sent to a gRPC Log Server, either via a Unary or Stream RPC
+//
+// If the gRPC Log Client is configured with hooks, these are executed before the
+// message is sent; and vetoed messages are dropped. Once the client is closed, it
+// returns log.ErrClosed -- also for writes which are waiting on the message channel
+// when the client is closed
 func (c *GRPCLogClient) Output(m *event.Event) (n int, err error) {
-	c.msgCh <- m
-	return 1, nil
+	if c.isClosed() {
+		c.metrics.add(droppedCounter)
+		return 0, log.ErrClosed
+	}
+
+	if !c.hooks.Fire(m) {
+		return 0, nil
+	}
+
+	c.pending.add(m)
+
+	select {
+	case c.msgCh <- m:
+		return 1, nil
+	case <-c.closing:
+		c.pending.done(m)
+		c.metrics.add(droppedCounter)
+		return 0, log.ErrClosed
+	}
 }
 
 // SetOuts method implements the Logger's SetOuts().
@@ -846,6 +929,48 @@ func (c *GRPCLogClient) Fields(fields map[string]interface{}) log.Logger {
 	return c
 }
 
+// With method implements the Logger interface.
+//
+// It returns a child gRPC Log Client with the input metadata fields merged on top of this
+// client's own (the input fields take precedence). It is a shortcut for
+// `GRPCLogClient.Child("", "", fields)`
+func (c *GRPCLogClient) With(fields map[string]interface{}) log.Logger {
+	return c.Child("", "", fields)
+}
+
+// Child method implements the Logger interface.
+//
+// It returns a new gRPC Log Client which inherits this client's prefix, sub-prefix and metadata
+// fields, replacing the prefix and sub-prefix with the input ones (if not empty), and merging the
+// input fields on top of the inherited metadata.
+//
+// The child shares the same connection(s), message channel, backoff, hooks and service logger as
+// its parent -- only its prefix, sub-prefix and fields are its own. As such, closing the child
+// (or its parent) will close the connection for both
+func (c *GRPCLogClient) Child(prefix, sub string, fields map[string]interface{}) log.Logger {
+	child := *c
+
+	if prefix != "" {
+		child.prefix = prefix
+	}
+
+	if sub != "" {
+		child.sub = sub
+	}
+
+	child.meta = make(map[string]interface{}, len(c.meta)+len(fields))
+
+	for k, v := range c.meta {
+		child.meta[k] = v
+	}
+
+	for k, v := range fields {
+		child.meta[k] = v
+	}
+
+	return &child
+}
+
 // IsSkipExit method implements the Printer interface.
 //
 // IsSkipExit method returns a boolean on whether the gRPC Log Client's service logger is
@@ -860,6 +985,15 @@ func (c *GRPCLogClient) IsSkipExit() bool {
 	return c.svcLogger.IsSkipExit()
 }
 
+// Start method implements the Logger interface.
+//
+// It starts a log.Scope named `name`, sending its start event (with the input metadata fields) to
+// the gRPC Log Server; and returns it, so that the operation is finished with its End() method.
+// The Scope's events are written with the default levels, as trace and debug
+func (c *GRPCLogClient) Start(name string, fields map[string]interface{}) *log.Scope {
+	return log.StartScope(c, name, fields)
+}
+
 // Log method implements the Printer interface.
 //
 // It will take in a pointer to one or more LogMessages, and write it to the Logger's
@@ -1091,6 +1225,42 @@ func (c *GRPCLogClient) Errorf(format string, v ...interface{}) {
 	)
 }
 
+// Err method implements the Printer interface.
+//
+// It is similar to fmt.Print; and will print a message using an fmt.Sprint(v...) pattern, while
+// automatically applying LogLevel Error and recording the input error in the event.
+func (c *GRPCLogClient) Err(err error, v ...interface{}) {
+
+	c.Log(
+		event.New().
+			Level(event.Level_error).
+			Prefix(c.prefix).
+			Sub(c.sub).
+			Message(fmt.Sprint(v...)).
+			Err(err).
+			Metadata(c.meta).
+			Build(),
+	)
+}
+
+// Errf method implements the Printer interface.
+//
+// It is similar to fmt.Printf; and will print a message using an fmt.Sprintf(format, v...) pattern,
+// while automatically applying LogLevel Error and recording the input error in the event.
+func (c *GRPCLogClient) Errf(err error, format string, v ...interface{}) {
+
+	c.Log(
+		event.New().
+			Level(event.Level_error).
+			Prefix(c.prefix).
+			Sub(c.sub).
+			Message(fmt.Sprintf(format, v...)).
+			Err(err).
+			Metadata(c.meta).
+			Build(),
+	)
+}
+
 // Warn method implements the Printer interface.
 //
 // It is similar to fmt.Print; and will print a message using an fmt.Sprint(v...) pattern, while
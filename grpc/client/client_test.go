@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -1142,6 +1143,82 @@ func TestFields(t *testing.T) {
 
 }
 
+func TestChild(t *testing.T) {
+	module := "GRPCLogClient"
+	funcname := "Child()"
+
+	type test struct {
+		name   string
+		prefix string
+		sub    string
+		fields map[string]interface{}
+		wants  *GRPCLogClient
+	}
+
+	var tests = []test{
+		{
+			name:  "inherit all elements",
+			wants: &GRPCLogClient{prefix: "parent", sub: "parent-sub", meta: map[string]interface{}{"a": 1}},
+		},
+		{
+			name:   "replace the prefix and sub-prefix",
+			prefix: "child",
+			sub:    "child-sub",
+			wants:  &GRPCLogClient{prefix: "child", sub: "child-sub", meta: map[string]interface{}{"a": 1}},
+		},
+		{
+			name:   "merge and override fields",
+			fields: map[string]interface{}{"a": 2, "b": true},
+			wants:  &GRPCLogClient{prefix: "parent", sub: "parent-sub", meta: map[string]interface{}{"a": 2, "b": true}},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		msgCh := make(chan *event.Event)
+
+		l := &GRPCLogClient{
+			msgCh:  msgCh,
+			prefix: "parent",
+			sub:    "parent-sub",
+			meta:   map[string]interface{}{"a": 1},
+		}
+
+		child := l.Child(test.prefix, test.sub, test.fields).(*GRPCLogClient)
+
+		if child == l {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected a new client -- action: %s", idx, module, funcname, test.name)
+			return
+		}
+
+		if child.msgCh != msgCh {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected a shared message channel -- action: %s", idx, module, funcname, test.name)
+			return
+		}
+
+		if child.prefix != test.wants.prefix || child.sub != test.wants.sub || !reflect.DeepEqual(child.meta, test.wants.meta) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted [%s/%s] %v ; got [%s/%s] %v -- action: %s",
+				idx, module, funcname,
+				test.wants.prefix, test.wants.sub, test.wants.meta,
+				child.prefix, child.sub, child.meta,
+				test.name,
+			)
+			return
+		}
+
+		if l.prefix != "parent" || l.sub != "parent-sub" || !reflect.DeepEqual(l.meta, map[string]interface{}{"a": 1}) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] parent was modified: [%s/%s] %v -- action: %s", idx, module, funcname, l.prefix, l.sub, l.meta, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
 func TestIsSkipExit(t *testing.T) {
 	module := "GRPCLogClient"
 	funcname := "IsSkipExit()"
@@ -1196,6 +1273,54 @@ func TestIsSkipExit(t *testing.T) {
 
 }
 
+func TestClose(t *testing.T) {
+	module := "GRPCLogClient"
+	funcname := "Close()"
+
+	l := &GRPCLogClient{
+		addr:    &address.ConnAddr{},
+		msgCh:   make(chan *event.Event, 1),
+		done:    make(chan struct{}, 1),
+		closed:  new(int32),
+		closing: make(chan struct{}),
+		pending: newPending(),
+	}
+	child := l.With(map[string]interface{}{"a": 1})
+
+	for i := 0; i < 2; i++ {
+		if err := child.Close(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 0, module, funcname, err, "close the client")
+			return
+		}
+	}
+
+	if len(l.done) != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a single done signal; got %v -- action: %s", 0, module, funcname, len(l.done), "close the client")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "close the client")
+
+	for _, logger := range []log.Logger{l, child} {
+		if _, err := logger.Output(event.New().Message("null").Build()); !errors.Is(err, log.ErrClosed) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v -- action: %s", 1, module, funcname, err, "write after closing")
+			return
+		}
+
+		if _, err := logger.Write([]byte("null")); !errors.Is(err, log.ErrClosed) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v -- action: %s", 1, module, funcname, err, "write after closing")
+			return
+		}
+	}
+
+	if len(l.msgCh) != 0 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected message after closing -- action: %s", 1, module, funcname, "write after closing")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "write after closing")
+}
+
 func TestLog(t *testing.T) {
 	module := "GRPCLogClient"
 	funcname := "Log()"
@@ -2958,3 +3083,103 @@ func FuzzLoggerTracef(f *testing.F) {
 
 	})
 }
+
+func TestSyncPending(t *testing.T) {
+	module := "GRPCLogClient"
+	funcname := "Sync()"
+
+	l := &GRPCLogClient{
+		addr:    &address.ConnAddr{},
+		msgCh:   make(chan *event.Event),
+		done:    make(chan struct{}, 1),
+		closed:  new(int32),
+		closing: make(chan struct{}),
+		pending: newPending(),
+	}
+
+	var sent int32
+
+	// a listener which takes its time to send the events
+	go func() {
+		for m := range l.msgCh {
+			time.Sleep(50 * time.Millisecond)
+			atomic.AddInt32(&sent, 1)
+			l.pending.done(m)
+		}
+	}()
+	defer close(l.msgCh)
+
+	for i := 0; i < 2; i++ {
+		if _, err := l.With(nil).Output(event.New().Message("null").Build()); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 0, module, funcname, err, "wait for the events in flight")
+			return
+		}
+	}
+
+	if err := l.Sync(); err != nil || atomic.LoadInt32(&sent) != 2 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected 2 sent events; got %v with error %v -- action: %s", 0, module, funcname, atomic.LoadInt32(&sent), err, "wait for the events in flight")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "wait for the events in flight")
+}
+
+func TestOutputClosing(t *testing.T) {
+	module := "GRPCLogClient"
+	funcname := "Output()"
+
+	// no listener reads from the message channel
+	l := &GRPCLogClient{
+		addr:    &address.ConnAddr{},
+		msgCh:   make(chan *event.Event),
+		done:    make(chan struct{}, 1),
+		closed:  new(int32),
+		closing: make(chan struct{}),
+		pending: newPending(),
+		metrics: &clientMetrics{},
+	}
+
+	errCh := make(chan error)
+
+	go func() {
+		_, err := l.Output(event.New().Message("null").Build())
+		errCh <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+
+	closed := make(chan error)
+
+	go func() {
+		closed <- l.Close()
+	}()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, log.ErrClosed) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v -- action: %s", 0, module, funcname, err, "release a blocked write on close")
+			return
+		}
+	case <-time.After(time.Second):
+		t.Errorf("#%v -- FAILED -- [%s] [%s] write still blocked after closing -- action: %s", 0, module, funcname, "release a blocked write on close")
+		return
+	}
+
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error on close: %v -- action: %s", 0, module, funcname, err, "release a blocked write on close")
+			return
+		}
+	case <-time.After(time.Second):
+		t.Errorf("#%v -- FAILED -- [%s] [%s] close still blocked -- action: %s", 0, module, funcname, "release a blocked write on close")
+		return
+	}
+
+	if m := l.Metrics(); m.Dropped != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a dropped event; got %v -- action: %s", 0, module, funcname, m.Dropped, "release a blocked write on close")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "release a blocked write on close")
+}
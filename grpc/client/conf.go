@@ -11,6 +11,7 @@ import (
 
 	"github.com/zalgonoise/zlog/grpc/address"
 	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -136,6 +137,11 @@ type LSStreamInterceptor struct {
 // LSTiming struct is a custom LogClientConfig to add (debug) information on time taken to execute RPCs.
 type LSTiming struct{}
 
+// LSHook struct is a custom LogClientConfig to add a log.Hook to the new gRPC Log Client
+type LSHook struct {
+	hook log.Hook
+}
+
 // Apply method will set this option's address as the input gRPCLogClientBuilder's
 func (l LSAddr) Apply(ls *gRPCLogClientBuilder) {
 	ls.addr = &l.addr
@@ -182,6 +188,11 @@ func (l LSTiming) Apply(ls *gRPCLogClientBuilder) {
 	ls.interceptors.unaryItcp["timing"] = UnaryClientTiming(ls.svcLogger)
 }
 
+// Apply method will add this option's Hook to the input gRPCLogClientBuilder's hooks
+func (l LSHook) Apply(ls *gRPCLogClientBuilder) {
+	ls.hooks = append(ls.hooks, l.hook)
+}
+
 // Apply method will set this option's Unary interceptor on the gRPCLogClientBuilder
 func (l LSUnaryInterceptor) Apply(ls *gRPCLogClientBuilder) {
 	ls.interceptors.unaryItcp[l.name] = l.itcp
@@ -523,3 +534,18 @@ func WithStreamInterceptor(name string, itcp grpc.StreamClientInterceptor) LogCl
 		itcp: itcp,
 	}
 }
+
+// WithHook function will add a log.Hook to the gRPC Log Client, executing the input log.HookFunc
+// against events of the input levels (or all events, if none are provided) before they are sent
+// to the gRPC Log Server.
+//
+// Similar to log.WithHook(), the hooks can enrich, copy or veto the event.Event
+func WithHook(fn log.HookFunc, levels ...event.Level) LogClientConfig {
+	if fn == nil {
+		return nil
+	}
+
+	return &LSHook{
+		hook: log.NewHook(fn, levels...),
+	}
+}
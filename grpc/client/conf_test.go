@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -942,3 +943,33 @@ func TestWithTLS(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestWithHook(t *testing.T) {
+	module := "LogClientConfig"
+	funcname := "WithHook()"
+
+	if WithHook(nil) != nil {
+		t.Errorf("[%s] [%s] expected a nil config for a nil HookFunc", module, funcname)
+	}
+
+	var fired bool
+
+	builder := newGRPCLogClient(WithHook(func(m *event.Event) bool {
+		fired = true
+		return false
+	}))
+
+	if len(builder.hooks) != 1 {
+		t.Errorf("[%s] [%s] expected one hook in the builder; got %v", module, funcname, len(builder.hooks))
+		return
+	}
+
+	client := builder.build()
+
+	// a vetoed message is not pushed to the (unbuffered) message channel
+	n, err := client.Output(event.New().Message("vetoed").Build())
+
+	if n != 0 || err != nil || !fired {
+		t.Errorf("[%s] [%s] expected the message to be vetoed; got n=%v err=%v fired=%v", module, funcname, n, err, fired)
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"sync/atomic"
+
+	"github.com/zalgonoise/zlog/log"
+)
+
+// Metrics struct contains the counters of a gRPC Log Client (shared with its children), since
+// it was created:
+//   - Sent: the log messages delivered to gRPC Log Servers
+//   - Retried: the attempts to deliver messages which were deferred to the backoff module
+//   - Dropped: the log messages which could not be delivered, or were written after closing
+type Metrics struct {
+	Sent    uint64
+	Retried uint64
+	Dropped uint64
+}
+
+type counter int
+
+const (
+	sentCounter counter = iota
+	retriedCounter
+	droppedCounter
+)
+
+// clientMetrics type holds a gRPC Log Client's counters, updated atomically
+type clientMetrics [3]uint64
+
+// add method increments the input counter; it is a no-op on a nil clientMetrics
+func (m *clientMetrics) add(c counter) {
+	if m != nil {
+		atomic.AddUint64(&m[c], 1)
+	}
+}
+
+// load method returns the value of the input counter; or zero on a nil clientMetrics
+func (m *clientMetrics) load(c counter) uint64 {
+	if m == nil {
+		return 0
+	}
+
+	return atomic.LoadUint64(&m[c])
+}
+
+// Metrics method returns the gRPC Log Client's counters
+func (c *GRPCLogClient) Metrics() Metrics {
+	return Metrics{
+		Sent:    c.metrics.load(sentCounter),
+		Retried: c.metrics.load(retriedCounter),
+		Dropped: c.metrics.load(droppedCounter),
+	}
+}
+
+// Collect method implements the log.Collector interface, returning the gRPC Log Client's
+// Metrics as Counters
+func (c *GRPCLogClient) Collect() []log.Counter {
+	m := c.Metrics()
+
+	return []log.Counter{
+		{Name: "zlog_grpc_client_sent_total", Help: "Log messages delivered by the gRPC Log Client.", Value: m.Sent},
+		{Name: "zlog_grpc_client_retried_total", Help: "Deliveries deferred to the gRPC Log Client's backoff.", Value: m.Retried},
+		{Name: "zlog_grpc_client_dropped_total", Help: "Log messages the gRPC Log Client failed to deliver.", Value: m.Dropped},
+	}
+}
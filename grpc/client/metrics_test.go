@@ -0,0 +1,48 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/zalgonoise/zlog/grpc/address"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestMetrics(t *testing.T) {
+	module := "GRPCLogClient"
+	funcname := "Metrics()"
+
+	if m := (&GRPCLogClient{}).Metrics(); m != (Metrics{}) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected metrics: %+v -- action: %s", 0, module, funcname, m, "client without counters")
+		return
+	}
+
+	c := &GRPCLogClient{
+		addr:    &address.ConnAddr{},
+		done:    make(chan struct{}, 1),
+		closed:  new(int32),
+		metrics: &clientMetrics{},
+	}
+	child := c.With(map[string]interface{}{"a": 1}).(*GRPCLogClient)
+
+	c.metrics.add(sentCounter)
+	child.metrics.add(retriedCounter)
+
+	_ = c.Close()
+	_, _ = child.Output(event.New().Message("null").Build())
+
+	for _, client := range []*GRPCLogClient{c, child} {
+		if m := client.Metrics(); m != (Metrics{Sent: 1, Retried: 1, Dropped: 1}) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected metrics: %+v -- action: %s", 1, module, funcname, m, "share counters with children")
+			return
+		}
+	}
+
+	counters := c.Collect()
+
+	if len(counters) != 3 || counters[0].Name != "zlog_grpc_client_sent_total" || counters[2].Value != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected counters: %+v -- action: %s", 2, module, funcname, counters, "collect counters")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "count messages")
+}
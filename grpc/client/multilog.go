@@ -144,6 +144,26 @@ func (l *multiLogger) Fields(fields map[string]interface{}) log.Logger {
 	return l
 }
 
+func (l *multiLogger) With(fields map[string]interface{}) log.Logger {
+	return l.Child("", "", fields)
+}
+
+func (l *multiLogger) Child(prefix, sub string, fields map[string]interface{}) log.Logger {
+	loggers := make([]GRPCLogger, 0, len(l.loggers))
+
+	for _, logger := range l.loggers {
+		if child, ok := logger.Child(prefix, sub, fields).(GRPCLogger); ok {
+			loggers = append(loggers, child)
+		}
+	}
+
+	return MultiLogger(loggers...)
+}
+
+func (l *multiLogger) Start(name string, fields map[string]interface{}) *log.Scope {
+	return log.StartScope(l, name, fields)
+}
+
 func (l *multiLogger) IsSkipExit() bool {
 	for _, logger := range l.loggers {
 		ok := logger.IsSkipExit()
@@ -191,10 +211,50 @@ func (l *multiLogger) Write(p []byte) (n int, err error) {
 	return n, nil
 }
 
-func (l *multiLogger) Close() {
+func (l *multiLogger) Sync() error {
+	var errs []error
+
 	for _, logger := range l.loggers {
-		logger.Close()
+		if err := logger.Sync(); err != nil {
+			errs = append(errs, err)
+		}
 	}
+
+	return wrapErrors("syncing", errs)
+}
+
+func (l *multiLogger) Close() error {
+	var errs []error
+
+	for _, logger := range l.loggers {
+		if err := logger.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return wrapErrors("closing", errs)
+}
+
+func wrapErrors(action string, errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	var err error
+
+	for _, e := range errs {
+		if err == nil {
+			err = e
+		} else {
+			err = fmt.Errorf("%w ; %v", err, e)
+		}
+	}
+
+	return fmt.Errorf("multiple errors when %s: %w", action, err)
 }
 
 func (l *multiLogger) Channels() (chan *event.Event, chan struct{}) {
@@ -414,6 +474,24 @@ func (l *multiLogger) Errorf(format string, v ...interface{}) {
 	}
 }
 
+// Err method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Error and recording the
+// input error in the event.
+func (l *multiLogger) Err(err error, v ...interface{}) {
+	for _, logger := range l.loggers {
+		logger.Err(err, v...)
+	}
+}
+
+// Errf method (similar to fmt.Printf) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Error and recording the
+// input error in the event.
+func (l *multiLogger) Errf(err error, format string, v ...interface{}) {
+	for _, logger := range l.loggers {
+		logger.Errf(err, format, v...)
+	}
+}
+
 // Warn method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
 // across all configured Loggers, while automatically applying LogLevel Warn.
 func (l *multiLogger) Warn(v ...interface{}) {
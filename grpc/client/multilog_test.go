@@ -19,7 +19,8 @@ type testLogClient struct {
 }
 
 // ChanneledLogger impl
-func (l *testLogClient) Close() {}
+func (l *testLogClient) Sync() error  { return nil }
+func (l *testLogClient) Close() error { return nil }
 func (l *testLogClient) Channels() (chan *event.Event, chan struct{}) {
 	return make(chan *event.Event), make(chan struct{})
 }
@@ -57,37 +58,46 @@ func (l *testLogClient) AddOuts(outs ...io.Writer) log.Logger {
 func (l *testLogClient) Prefix(prefix string) log.Logger                 { return l }
 func (l *testLogClient) Sub(sub string) log.Logger                       { return l }
 func (l *testLogClient) Fields(fields map[string]interface{}) log.Logger { return l }
-func (l *testLogClient) IsSkipExit() bool                                { return l.skipExit }
+func (l *testLogClient) With(fields map[string]interface{}) log.Logger   { return l }
+func (l *testLogClient) Child(prefix, sub string, fields map[string]interface{}) log.Logger {
+	return l
+}
+func (l *testLogClient) IsSkipExit() bool { return l.skipExit }
+func (l *testLogClient) Start(name string, fields map[string]interface{}) *log.Scope {
+	return log.StartScope(l, name, fields)
+}
 
 // log.Printer impl
 func (l *testLogClient) Output(m *event.Event) (n int, err error) {
 	return l.Write(m.Encode())
 }
-func (l *testLogClient) Log(m ...*event.Event)                  {}
-func (l *testLogClient) Print(v ...interface{})                 {}
-func (l *testLogClient) Println(v ...interface{})               {}
-func (l *testLogClient) Printf(format string, v ...interface{}) {}
-func (l *testLogClient) Panic(v ...interface{})                 {}
-func (l *testLogClient) Panicln(v ...interface{})               {}
-func (l *testLogClient) Panicf(format string, v ...interface{}) {}
-func (l *testLogClient) Fatal(v ...interface{})                 {}
-func (l *testLogClient) Fatalln(v ...interface{})               {}
-func (l *testLogClient) Fatalf(format string, v ...interface{}) {}
-func (l *testLogClient) Error(v ...interface{})                 {}
-func (l *testLogClient) Errorln(v ...interface{})               {}
-func (l *testLogClient) Errorf(format string, v ...interface{}) {}
-func (l *testLogClient) Warn(v ...interface{})                  {}
-func (l *testLogClient) Warnln(v ...interface{})                {}
-func (l *testLogClient) Warnf(format string, v ...interface{})  {}
-func (l *testLogClient) Info(v ...interface{})                  {}
-func (l *testLogClient) Infoln(v ...interface{})                {}
-func (l *testLogClient) Infof(format string, v ...interface{})  {}
-func (l *testLogClient) Debug(v ...interface{})                 {}
-func (l *testLogClient) Debugln(v ...interface{})               {}
-func (l *testLogClient) Debugf(format string, v ...interface{}) {}
-func (l *testLogClient) Trace(v ...interface{})                 {}
-func (l *testLogClient) Traceln(v ...interface{})               {}
-func (l *testLogClient) Tracef(format string, v ...interface{}) {}
+func (l *testLogClient) Log(m ...*event.Event)                           {}
+func (l *testLogClient) Print(v ...interface{})                          {}
+func (l *testLogClient) Println(v ...interface{})                        {}
+func (l *testLogClient) Printf(format string, v ...interface{})          {}
+func (l *testLogClient) Panic(v ...interface{})                          {}
+func (l *testLogClient) Panicln(v ...interface{})                        {}
+func (l *testLogClient) Panicf(format string, v ...interface{})          {}
+func (l *testLogClient) Fatal(v ...interface{})                          {}
+func (l *testLogClient) Fatalln(v ...interface{})                        {}
+func (l *testLogClient) Fatalf(format string, v ...interface{})          {}
+func (l *testLogClient) Error(v ...interface{})                          {}
+func (l *testLogClient) Errorln(v ...interface{})                        {}
+func (l *testLogClient) Errorf(format string, v ...interface{})          {}
+func (l *testLogClient) Err(err error, v ...interface{})                 {}
+func (l *testLogClient) Errf(err error, format string, v ...interface{}) {}
+func (l *testLogClient) Warn(v ...interface{})                           {}
+func (l *testLogClient) Warnln(v ...interface{})                         {}
+func (l *testLogClient) Warnf(format string, v ...interface{})           {}
+func (l *testLogClient) Info(v ...interface{})                           {}
+func (l *testLogClient) Infoln(v ...interface{})                         {}
+func (l *testLogClient) Infof(format string, v ...interface{})           {}
+func (l *testLogClient) Debug(v ...interface{})                          {}
+func (l *testLogClient) Debugln(v ...interface{})                        {}
+func (l *testLogClient) Debugf(format string, v ...interface{})          {}
+func (l *testLogClient) Trace(v ...interface{})                          {}
+func (l *testLogClient) Traceln(v ...interface{})                        {}
+func (l *testLogClient) Tracef(format string, v ...interface{})          {}
 
 func TestMultiLogger(t *testing.T) {
 	module := "GRPCLogger"
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// pending struct tracks the events pushed to the message channel by a gRPC Log Client's Output()
+// method (shared with its children), until they are sent to the gRPC Log Server(s) or dropped;
+// so that Sync() and Close() can wait for the events in flight.
+//
+// Events pushed directly to the message channel (see Channels()) are not tracked
+type pending struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	count  int
+	events map[*event.Event]int
+}
+
+// newPending function creates a pending with no events in flight
+func newPending() *pending {
+	p := &pending{
+		events: map[*event.Event]int{},
+	}
+
+	p.cond = sync.NewCond(&p.mu)
+
+	return p
+}
+
+// add method registers the input event as in flight; it is a no-op on a nil pending
+func (p *pending) add(m *event.Event) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events[m]++
+	p.count++
+}
+
+// done method registers the input event as sent (or dropped), if it is in flight; it is a no-op
+// on a nil pending
+func (p *pending) done(m *event.Event) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n, ok := p.events[m]
+	if !ok {
+		return
+	}
+
+	if n == 1 {
+		delete(p.events, m)
+	} else {
+		p.events[m] = n - 1
+	}
+
+	p.count--
+
+	if p.count == 0 {
+		p.cond.Broadcast()
+	}
+}
+
+// wait method blocks until there are no events in flight; it is a no-op on a nil pending
+func (p *pending) wait() {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for p.count > 0 {
+		p.cond.Wait()
+	}
+}
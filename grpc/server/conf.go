@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"os"
+	"time"
 
 	"github.com/zalgonoise/zlog/log"
 	"google.golang.org/grpc"
@@ -126,6 +127,16 @@ type LSStreamInterceptor struct {
 	itcp grpc.StreamServerInterceptor
 }
 
+// LSRedactor struct is a custom LogServerConfig to scrub incoming events with a Redactor
+type LSRedactor struct {
+	r log.Redactor
+}
+
+// LSDedup struct is a custom LogServerConfig to suppress repeated incoming events with a Deduper
+type LSDedup struct {
+	d *log.Deduper
+}
+
 // Apply method will set this option's address as the input GRPCLogServer's
 func (l LSAddr) Apply(ls *gRPCLogServerBuilder) {
 	ls.addr = l.addr
@@ -178,6 +189,18 @@ func (l LSStreamInterceptor) Apply(ls *gRPCLogServerBuilder) {
 	ls.interceptors.streamItcp[l.name] = l.itcp
 }
 
+// Apply method will set this option's Redactor as Unary and Stream interceptors on the
+// gRPCLogServerBuilder
+func (l LSRedactor) Apply(ls *gRPCLogServerBuilder) {
+	ls.interceptors.unaryItcp["redact"] = UnaryServerRedact(l.r)
+	ls.interceptors.streamItcp["redact"] = StreamServerRedact(l.r)
+}
+
+// Apply method will set this option's Deduper as the input GRPCLogServer's
+func (l LSDedup) Apply(ls *gRPCLogServerBuilder) {
+	ls.dedup = l.d
+}
+
 // WithAddr function will take one address for the gRPC Log Server to listen to.
 //
 // If this address is empty, defaults are applied (localhost:9099)
@@ -396,3 +419,34 @@ func WithStreamInterceptor(name string, itcp grpc.StreamServerInterceptor) LogSe
 		itcp: itcp,
 	}
 }
+
+// WithRedactor function will set a gRPC Log Server to scrub the events it receives with the
+// input Redactor (such as one from the log/redact package), before they are written by its logger.
+//
+// This is useful when the gRPC clients are not trusted to redact their own events. It returns
+// nil if the Redactor is nil
+func WithRedactor(r log.Redactor) LogServerConfig {
+	if r == nil {
+		return nil
+	}
+
+	return &LSRedactor{
+		r: r,
+	}
+}
+
+// WithDedup function will set a gRPC Log Server to suppress the repeated events it receives
+// within the input time window, before they are written by its logger. Once the window elapses,
+// a summary event is written with the number of repeats (see log.WithDedup).
+//
+// Events are keyed on their level, prefix, sub-prefix and message, as well as on the values of the
+// input metadata keys (if any). It returns nil if the window is zero or negative
+func WithDedup(window time.Duration, keys ...string) LogServerConfig {
+	if window <= 0 {
+		return nil
+	}
+
+	return &LSDedup{
+		d: log.NewDeduper(window, keys...),
+	}
+}
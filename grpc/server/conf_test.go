@@ -717,3 +717,20 @@ func TestWithTLS(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestWithDedup(t *testing.T) {
+	module := "LogServerConfig"
+	funcname := "WithDedup()"
+
+	if WithDedup(0) != nil {
+		t.Errorf("[%s] [%s] expected a nil config for a zero window", module, funcname)
+	}
+
+	builder := &gRPCLogServerBuilder{}
+
+	WithDedup(time.Second, "code").Apply(builder)
+
+	if builder.dedup == nil {
+		t.Errorf("[%s] [%s] expected a Deduper to be set", module, funcname)
+	}
+}
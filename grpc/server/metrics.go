@@ -0,0 +1,67 @@
+package server
+
+import (
+	"sync/atomic"
+
+	"github.com/zalgonoise/zlog/log"
+)
+
+// Metrics struct contains the counters of a gRPC Log Server, since it was created:
+//   - Received: the log messages received from gRPC Log Clients
+//   - Stored: the received messages written to the server's Logger
+//   - Failed: the received messages which could not be written to the server's Logger
+//
+// Messages suppressed by the server's deduper are received, but neither stored nor failed
+type Metrics struct {
+	Received uint64
+	Stored   uint64
+	Failed   uint64
+}
+
+type counter int
+
+const (
+	receivedCounter counter = iota
+	storedCounter
+	failedCounter
+)
+
+// serverMetrics type holds a gRPC Log Server's counters, updated atomically
+type serverMetrics [3]uint64
+
+// add method increments the input counter; it is a no-op on a nil serverMetrics
+func (m *serverMetrics) add(c counter) {
+	if m != nil {
+		atomic.AddUint64(&m[c], 1)
+	}
+}
+
+// load method returns the value of the input counter; or zero on a nil serverMetrics
+func (m *serverMetrics) load(c counter) uint64 {
+	if m == nil {
+		return 0
+	}
+
+	return atomic.LoadUint64(&m[c])
+}
+
+// Metrics method returns the gRPC Log Server's counters
+func (s GRPCLogServer) Metrics() Metrics {
+	return Metrics{
+		Received: s.metrics.load(receivedCounter),
+		Stored:   s.metrics.load(storedCounter),
+		Failed:   s.metrics.load(failedCounter),
+	}
+}
+
+// Collect method implements the log.Collector interface, returning the gRPC Log Server's
+// Metrics as Counters
+func (s GRPCLogServer) Collect() []log.Counter {
+	m := s.Metrics()
+
+	return []log.Counter{
+		{Name: "zlog_grpc_server_received_total", Help: "Log messages received by the gRPC Log Server.", Value: m.Received},
+		{Name: "zlog_grpc_server_stored_total", Help: "Log messages written to the gRPC Log Server's logger.", Value: m.Stored},
+		{Name: "zlog_grpc_server_failed_total", Help: "Log messages the gRPC Log Server failed to write.", Value: m.Failed},
+	}
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestMetrics(t *testing.T) {
+	module := "GRPCLogServer"
+	funcname := "Metrics()"
+
+	s := New(
+		WithLogger(log.New(log.WithOut(new(bytes.Buffer)), log.SkipExit)),
+		WithServiceLogger(log.New(log.NilConfig)),
+	)
+
+	failing := New(
+		WithLogger(log.New(log.WithOut(&failingWriter{}), log.SkipExit)),
+		WithServiceLogger(log.New(log.NilConfig)),
+	)
+
+	for _, srv := range []*GRPCLogServer{s, s, failing} {
+		go srv.handleResponses(event.New().Message("null").Build())
+		<-srv.logSv.Resp
+	}
+
+	if m := s.Metrics(); m != (Metrics{Received: 2, Stored: 2}) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected metrics: %+v -- action: %s", 0, module, funcname, m, "store messages")
+		return
+	}
+
+	if m := failing.Metrics(); m != (Metrics{Received: 1, Failed: 1}) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected metrics: %+v -- action: %s", 1, module, funcname, m, "fail to store messages")
+		return
+	}
+
+	counters := s.Collect()
+
+	if len(counters) != 3 || !strings.HasPrefix(counters[0].Name, "zlog_grpc_server_") || counters[1].Value != 2 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected counters: %+v -- action: %s", 2, module, funcname, counters, "collect counters")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "count messages")
+}
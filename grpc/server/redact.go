@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// UnaryServerRedact returns a new unary server interceptor that scrubs the incoming event.Events
+// with the input Redactor, before they are handled (and written) by the gRPC Log Server
+func UnaryServerRedact(r log.Redactor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if m, ok := req.(*event.Event); ok {
+			r.Redact(m)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerRedact returns a new stream server interceptor that scrubs the incoming event.Events
+// with the input Redactor, before they are handled (and written) by the gRPC Log Server
+//
+// Similar to the logging interceptor, the stream is wrapped so that each received message
+// is redacted as soon as it is read.
+func StreamServerRedact(r log.Redactor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, redactStream{
+			stream:   stream,
+			redactor: r,
+		})
+	}
+}
+
+// redactStream struct wraps a grpc.ServerStream, redacting the event.Events it receives with
+// its Redactor; the remaining calls are passed through to the underlying stream unchanged
+type redactStream struct {
+	stream   grpc.ServerStream
+	redactor log.Redactor
+}
+
+// SetHeader method passes the input metadata through to the underlying stream's SetHeader() method
+func (w redactStream) SetHeader(m metadata.MD) error { return w.stream.SetHeader(m) }
+
+// SendHeader method passes the input metadata through to the underlying stream's SendHeader() method
+func (w redactStream) SendHeader(m metadata.MD) error { return w.stream.SendHeader(m) }
+
+// SetTrailer method passes the input metadata through to the underlying stream's SetTrailer() method
+func (w redactStream) SetTrailer(m metadata.MD) { w.stream.SetTrailer(m) }
+
+// Context method returns the underlying stream's context
+func (w redactStream) Context() context.Context { return w.stream.Context() }
+
+// SendMsg method sends the input message through the underlying stream, as-is; only the
+// received events are redacted
+func (w redactStream) SendMsg(m interface{}) error { return w.stream.SendMsg(m) }
+
+// RecvMsg method reads the next message from the underlying stream and, if it is an
+// event.Event, scrubs it with the stream's Redactor before returning it to the handler.
+// Messages are not redacted when the read fails
+func (w redactStream) RecvMsg(m interface{}) error {
+	err := w.stream.RecvMsg(m)
+
+	if err != nil {
+		return err
+	}
+
+	if msg, ok := m.(*event.Event); ok {
+		w.redactor.Redact(msg)
+	}
+
+	return nil
+}
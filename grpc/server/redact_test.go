@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/log/redact"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryServerRedact(t *testing.T) {
+	module := "LogServer Interceptors"
+	funcname := "UnaryServerRedact()"
+
+	r := redact.New(redact.Key("token", redact.Replace))
+	req := event.New().Message("null").Metadata(map[string]interface{}{"token": "secret"}).Build()
+
+	var handler grpc.UnaryHandler = func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	res, err := UnaryServerRedact(r)(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "test"}, handler)
+
+	if err != nil {
+		t.Errorf("[%s] [%s] unexpected error: %v", module, funcname, err)
+		return
+	}
+
+	if token := res.(*event.Event).GetMeta().AsMap()["token"]; token != redact.Placeholder {
+		t.Errorf("[%s] [%s] expected the token to be redacted; got %v", module, funcname, token)
+	}
+}
+
+func TestStreamServerRedact(t *testing.T) {
+	module := "LogServer Interceptors"
+	funcname := "StreamServerRedact()"
+
+	type test struct {
+		name  string
+		input *event.Event
+		ok    bool
+	}
+
+	var tests = []test{
+		{
+			name:  "redacted stream message",
+			input: event.New().Message("null").Metadata(map[string]interface{}{"token": "secret"}).Build(),
+			ok:    true,
+		},
+		{
+			name:  "failed stream message",
+			input: event.New().Level(event.Level_error).Message("null").Metadata(map[string]interface{}{"token": "secret"}).Build(),
+		},
+	}
+
+	r := redact.New(redact.Key("token", redact.Replace))
+
+	var verify = func(idx int, test test) {
+		var handler grpc.StreamHandler = func(srv interface{}, stream grpc.ServerStream) error {
+			return stream.RecvMsg(test.input)
+		}
+
+		err := StreamServerRedact(r)(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "test"}, handler)
+
+		if test.ok && err != nil || !test.ok && err == nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		token := test.input.GetMeta().AsMap()["token"]
+
+		if test.ok && token != redact.Placeholder || !test.ok && token != "secret" {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected token value: %v -- action: %s", idx, module, funcname, token, test.name)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestWithRedactor(t *testing.T) {
+	module := "LogServerConfig"
+	funcname := "WithRedactor()"
+
+	if WithRedactor(nil) != nil {
+		t.Errorf("[%s] [%s] expected a nil config for a nil Redactor", module, funcname)
+	}
+
+	builder := &gRPCLogServerBuilder{
+		interceptors: serverInterceptors{
+			streamItcp: make(map[string]grpc.StreamServerInterceptor),
+			unaryItcp:  make(map[string]grpc.UnaryServerInterceptor),
+		},
+	}
+
+	WithRedactor(redact.New()).Apply(builder)
+
+	if builder.interceptors.unaryItcp["redact"] == nil || builder.interceptors.streamItcp["redact"] == nil {
+		t.Errorf("[%s] [%s] expected redact interceptors to be set", module, funcname)
+	}
+}
@@ -47,6 +47,8 @@ type GRPCLogServer struct {
 	svcLogger log.Logger
 	errCh     chan error
 	logSv     *pb.LogServer
+	dedup     *log.Deduper
+	metrics   *serverMetrics
 }
 
 // gRPCLogServerBuilder is a helper data structure to spawn new GRPCLogServers
@@ -56,6 +58,7 @@ type gRPCLogServerBuilder struct {
 	interceptors serverInterceptors
 	logger       log.Logger
 	svcLogger    log.Logger
+	dedup        *log.Deduper
 }
 
 // build method will merge the stream / unary gRPC interceptors as []grpc.ServerOption
@@ -92,6 +95,8 @@ func (b *gRPCLogServerBuilder) build() *GRPCLogServer {
 		svcLogger: b.svcLogger,
 		errCh:     make(chan error),
 		logSv:     pb.NewLogServer(),
+		dedup:     b.dedup,
+		metrics:   &serverMetrics{},
 	}
 
 }
@@ -128,6 +133,13 @@ func New(confs ...LogServerConfig) *GRPCLogServer {
 	// merge configurations / server options & interceptors
 	server := builder.build()
 
+	// summaries of repeated events are written to the (served) logger
+	if server.dedup != nil {
+		server.dedup.Bind(func(m *event.Event) {
+			_, _ = server.logger.Output(m) // deliberately ignore error in this method call
+		})
+	}
+
 	go server.registerComms()
 
 	return server
@@ -176,14 +188,30 @@ func (s GRPCLogServer) listen() net.Listener {
 // number of bytes written and an error. From this point, depending on the outcome,
 // a pb.LogResponse object is built and sent to the Responses channel
 func (s GRPCLogServer) handleResponses(logmsg *event.Event) {
-	n, err := s.logger.Output(logmsg)
-	n32 := int32(n)
-
 	// generate request ID
 	reqID := uuid.New().String()
 
+	s.metrics.add(receivedCounter)
+
+	// suppress repeated events, which are reported in a summary event
+	if s.dedup != nil && !s.dedup.Dedup(logmsg) {
+		var n32 int32
+
+		s.logSv.Resp <- &pb.LogResponse{
+			Ok:    true,
+			ReqID: reqID,
+			Bytes: &n32,
+		}
+		return
+	}
+
+	n, err := s.logger.Output(logmsg)
+	n32 := int32(n)
+
 	// handle write errors or zero-bytes-written errors
 	if err != nil || n == 0 {
+		s.metrics.add(failedCounter)
+
 		var errStr string
 		if err == nil {
 			errStr = "zero bytes written"
@@ -203,6 +231,8 @@ func (s GRPCLogServer) handleResponses(logmsg *event.Event) {
 		return
 	}
 
+	s.metrics.add(storedCounter)
+
 	s.svcLogger.Log(event.New().Level(event.Level_debug).Prefix("gRPC").Sub("handler").Message("input log message parsed and registered").Build())
 
 	// send OK response
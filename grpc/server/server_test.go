@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -421,3 +422,44 @@ func TestHandleMesages(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestHandleResponsesDedup(t *testing.T) {
+	module := "GRPCLogServer"
+	funcname := "handleResponses()"
+
+	buf := new(bytes.Buffer)
+	s := New(
+		WithLogger(log.New(log.WithOut(buf), log.SkipExit, log.CfgFormatJSON)),
+		WithServiceLogger(log.New(log.NilConfig)),
+		WithDedup(time.Hour),
+	)
+
+	var written int32
+
+	for i := 0; i < 3; i++ {
+		go s.handleResponses(event.New().Level(event.Level_error).Message("null").Build())
+
+		select {
+		case res := <-s.logSv.Resp:
+			if !res.GetOk() {
+				t.Errorf("[%s] [%s] unexpected response: %v", module, funcname, res)
+				return
+			}
+			written += res.GetBytes()
+		case <-time.After(maxTestWait):
+			t.Errorf("[%s] [%s] timed out waiting for a response", module, funcname)
+			return
+		}
+	}
+
+	if n := strings.Count(buf.String(), `"message":"null"`); n != 1 || written == 0 {
+		t.Errorf("[%s] [%s] expected one written event; got %v: %s", module, funcname, n, buf.String())
+		return
+	}
+
+	s.dedup.Flush()
+
+	if !strings.Contains(buf.String(), "null -- message repeated 2 times in 1h0m0s") {
+		t.Errorf("[%s] [%s] summary event not found in output: %s", module, funcname, buf.String())
+	}
+}
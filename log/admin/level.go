@@ -0,0 +1,269 @@
+// Package admin provides HTTP handlers to inspect and reconfigure live Loggers, such as
+// changing their level filter without restarting the service
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+var (
+	ErrInvalidLevel    error = errors.New("invalid log level")
+	ErrInvalidDuration error = errors.New("invalid duration")
+	ErrNotFound        error = errors.New("logger not found")
+	ErrMethod          error = errors.New("method not allowed")
+)
+
+// LevelHandler struct is an http.Handler that reads (GET) and changes (PUT) the level filter
+// of the Loggers registered to it, by name.
+//
+// A GET request lists the levels of all registered Loggers, or of a single one with the
+// `name` query parameter:
+//
+//	GET /log/level?name=api
+//	{"loggers":{"api":"info"}}
+//
+// A PUT request changes the level of a Logger (or of all of them, if no name is provided). If a
+// duration is provided, the previous level is restored once it elapses:
+//
+//	PUT /log/level
+//	{"name":"api","level":"debug","duration":"5m"}
+type LevelHandler struct {
+	mu      sync.Mutex
+	loggers map[string]*levelEntry
+}
+
+type levelEntry struct {
+	l      log.Leveler
+	timer  *time.Timer
+	revert event.Level
+}
+
+// LevelRequest struct is the JSON body of a PUT request to a LevelHandler
+type LevelRequest struct {
+	Name     string `json:"name,omitempty"`
+	Level    string `json:"level"`
+	Duration string `json:"duration,omitempty"`
+}
+
+// LevelResponse struct is the JSON body of a LevelHandler's responses
+type LevelResponse struct {
+	Loggers map[string]string `json:"loggers,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// NewLevelHandler function will create a LevelHandler with the input Loggers registered
+// by name. Loggers can also be registered later on with its Register() method
+func NewLevelHandler(loggers map[string]log.Leveler) *LevelHandler {
+	h := &LevelHandler{
+		loggers: map[string]*levelEntry{},
+	}
+
+	for name, l := range loggers {
+		h.Register(name, l)
+	}
+
+	return h
+}
+
+// Register method will add the input Leveler (such as a Logger created with log.New()) to the
+// LevelHandler as `name`, replacing any Logger registered with the same name
+func (h *LevelHandler) Register(name string, l log.Leveler) {
+	if l == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if e, ok := h.loggers[name]; ok && e.timer != nil {
+		e.timer.Stop()
+	}
+
+	h.loggers[name] = &levelEntry{l: l}
+}
+
+// Unregister method will remove the Logger registered as `name` from the LevelHandler
+func (h *LevelHandler) Unregister(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if e, ok := h.loggers[name]; ok && e.timer != nil {
+		e.timer.Stop()
+	}
+
+	delete(h.loggers, name)
+}
+
+// Names method returns the sorted names of the Loggers registered in the LevelHandler
+func (h *LevelHandler) Names() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	names := make([]string, 0, len(h.loggers))
+
+	for name := range h.loggers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// ServeHTTP method implements the http.Handler interface
+func (h *LevelHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.get(w, r.URL.Query().Get("name"))
+	case http.MethodPut:
+		h.put(w, r)
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		respond(w, http.StatusMethodNotAllowed, LevelResponse{Error: ErrMethod.Error()})
+	}
+}
+
+func (h *LevelHandler) get(w http.ResponseWriter, name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries, err := h.lookup(name)
+
+	if err != nil {
+		respond(w, http.StatusNotFound, LevelResponse{Error: err.Error()})
+		return
+	}
+
+	respond(w, http.StatusOK, levels(entries))
+}
+
+func (h *LevelHandler) put(w http.ResponseWriter, r *http.Request) {
+	var req LevelRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond(w, http.StatusBadRequest, LevelResponse{Error: err.Error()})
+		return
+	}
+
+	level, err := ParseLevel(req.Level)
+
+	if err != nil {
+		respond(w, http.StatusBadRequest, LevelResponse{Error: err.Error()})
+		return
+	}
+
+	var dur time.Duration
+
+	if req.Duration != "" {
+		dur, err = time.ParseDuration(req.Duration)
+
+		if err != nil || dur <= 0 {
+			respond(w, http.StatusBadRequest, LevelResponse{Error: ErrInvalidDuration.Error()})
+			return
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries, err := h.lookup(req.Name)
+
+	if err != nil {
+		respond(w, http.StatusNotFound, LevelResponse{Error: err.Error()})
+		return
+	}
+
+	for _, e := range entries {
+		h.set(e, level, dur)
+	}
+
+	respond(w, http.StatusOK, levels(entries))
+}
+
+// set method will change the level of the input entry, scheduling a revert to its original
+// level if the input duration is greater than zero. It must be called while holding the lock
+func (h *LevelHandler) set(e *levelEntry, level event.Level, dur time.Duration) {
+	if e.timer != nil {
+		// keep the level set before the first temporary change
+		e.timer.Stop()
+		e.timer = nil
+	} else {
+		e.revert = e.l.Level()
+	}
+
+	e.l.SetLevel(level)
+
+	if dur <= 0 {
+		return
+	}
+
+	var t *time.Timer
+
+	t = time.AfterFunc(dur, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		// the timer fired while another change held the lock, and was replaced (or stopped)
+		if e.timer != t {
+			return
+		}
+
+		e.l.SetLevel(e.revert)
+		e.timer = nil
+	})
+
+	e.timer = t
+}
+
+// lookup method returns the entries matching the input name, or all of them if the name
+// is empty. It must be called while holding the lock
+func (h *LevelHandler) lookup(name string) (map[string]*levelEntry, error) {
+	if name == "" {
+		return h.loggers, nil
+	}
+
+	e, ok := h.loggers[name]
+
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return map[string]*levelEntry{name: e}, nil
+}
+
+func levels(entries map[string]*levelEntry) LevelResponse {
+	res := LevelResponse{
+		Loggers: make(map[string]string, len(entries)),
+	}
+
+	for name, e := range entries {
+		res.Loggers[name] = e.l.Level().String()
+	}
+
+	return res
+}
+
+func respond(w http.ResponseWriter, status int, res LevelResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(res) // deliberately ignore error in this method call
+}
+
+// ParseLevel function will convert the input string (such as "debug" or "WARN") into an
+// event.Level, returning ErrInvalidLevel if it does not match any
+func ParseLevel(s string) (event.Level, error) {
+	if v, ok := event.Level_value[strings.ToLower(strings.TrimSpace(s))]; ok {
+		return event.Level(v), nil
+	}
+
+	return event.Level_info, ErrInvalidLevel
+}
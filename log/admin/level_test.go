@@ -0,0 +1,229 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestLevelHandler(t *testing.T) {
+	module := "LevelHandler"
+	funcname := "ServeHTTP()"
+
+	type test struct {
+		name   string
+		method string
+		target string
+		body   string
+		status int
+		wants  map[string]string
+	}
+
+	var tests = []test{
+		{
+			name:   "list all loggers",
+			method: http.MethodGet,
+			target: "/",
+			status: http.StatusOK,
+			wants:  map[string]string{"api": "trace", "db": "warn"},
+		},
+		{
+			name:   "get a single logger",
+			method: http.MethodGet,
+			target: "/?name=db",
+			status: http.StatusOK,
+			wants:  map[string]string{"db": "warn"},
+		},
+		{
+			name:   "get an unknown logger",
+			method: http.MethodGet,
+			target: "/?name=cache",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "set a single logger",
+			method: http.MethodPut,
+			target: "/",
+			body:   `{"name":"api","level":"debug"}`,
+			status: http.StatusOK,
+			wants:  map[string]string{"api": "debug"},
+		},
+		{
+			name:   "set all loggers",
+			method: http.MethodPut,
+			target: "/",
+			body:   `{"level":"ERROR"}`,
+			status: http.StatusOK,
+			wants:  map[string]string{"api": "error", "db": "error"},
+		},
+		{
+			name:   "invalid level",
+			method: http.MethodPut,
+			target: "/",
+			body:   `{"level":"verbose"}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid duration",
+			method: http.MethodPut,
+			target: "/",
+			body:   `{"level":"debug","duration":"soon"}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid body",
+			method: http.MethodPut,
+			target: "/",
+			body:   `{`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid method",
+			method: http.MethodDelete,
+			target: "/",
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		h := NewLevelHandler(map[string]log.Leveler{
+			"api": log.New(log.SkipExit).(log.Leveler),
+			"db":  log.New(log.SkipExit, log.WithFilter(event.Level_warn)).(log.Leveler),
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(test.method, test.target, strings.NewReader(test.body)))
+
+		if rec.Code != test.status {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] status mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.status, rec.Code, test.name)
+			return
+		}
+
+		var res LevelResponse
+
+		if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error decoding response: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		if test.wants != nil && !reflect.DeepEqual(res.Loggers, test.wants) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, res.Loggers, test.name)
+			return
+		}
+
+		if test.status != http.StatusOK && res.Error == "" {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected an error message -- action: %s", idx, module, funcname, test.name)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestLevelHandlerRevert(t *testing.T) {
+	module := "LevelHandler"
+	funcname := "ServeHTTP()"
+
+	buf := new(bytes.Buffer)
+	logger := log.New(log.WithOut(buf), log.SkipExit, log.CfgTextOnly, log.WithFilter(event.Level_info))
+
+	h := NewLevelHandler(nil)
+	h.Register("api", logger.(log.Leveler))
+
+	for _, body := range []string{
+		`{"name":"api","level":"debug","duration":"20ms"}`,
+		`{"name":"api","level":"trace","duration":"20ms"}`,
+	} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body)))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("[%s] [%s] unexpected status: %v", module, funcname, rec.Code)
+		}
+	}
+
+	logger.Trace("temporary")
+
+	if buf.String() != "temporary\n" {
+		t.Errorf("[%s] [%s] expected trace events to be written; got %q", module, funcname, buf.String())
+	}
+
+	deadline := time.Now().Add(time.Second)
+
+	for logger.(log.Leveler).Level() != event.Level_info && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if level := logger.(log.Leveler).Level(); level != event.Level_info {
+		t.Errorf("[%s] [%s] expected the level to revert to info; got %v", module, funcname, level)
+	}
+
+	h.Unregister("api")
+
+	if names := h.Names(); len(names) != 0 {
+		t.Errorf("[%s] [Unregister()] expected no registered loggers; got %v", module, names)
+	}
+}
+
+func TestLevelHandlerRevertRace(t *testing.T) {
+	module := "LevelHandler"
+	funcname := "ServeHTTP()"
+
+	logger := log.New(log.WithOut(new(bytes.Buffer)), log.SkipExit, log.CfgTextOnly, log.WithFilter(event.Level_info))
+
+	h := NewLevelHandler(nil)
+	h.Register("api", logger.(log.Leveler))
+
+	put := func(body string) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body)))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("[%s] [%s] unexpected status: %v", module, funcname, rec.Code)
+		}
+	}
+
+	put(`{"name":"api","level":"debug","duration":"10ms"}`)
+
+	// let the temporary change expire while a permanent one holds the lock, as in a concurrent PUT
+	h.mu.Lock()
+	time.Sleep(50 * time.Millisecond)
+	h.set(h.loggers["api"], event.Level_warn, 0)
+	h.mu.Unlock()
+
+	time.Sleep(50 * time.Millisecond)
+
+	if level := logger.(log.Leveler).Level(); level != event.Level_warn {
+		t.Errorf("[%s] [%s] expected the permanent level to be kept; got %v", module, funcname, level)
+	}
+
+	put(`{"name":"api","level":"trace","duration":"10ms"}`)
+
+	deadline := time.Now().Add(time.Second)
+
+	for logger.(log.Leveler).Level() != event.Level_warn && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if level := logger.(log.Leveler).Level(); level != event.Level_warn {
+		t.Errorf("[%s] [%s] expected the level to revert to the permanent one; got %v", module, funcname, level)
+	}
+}
@@ -0,0 +1,200 @@
+package admin
+
+import (
+	"bufio"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/zalgonoise/zlog/log"
+)
+
+// contentType is the media type of the Prometheus text exposition format
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// MetricsHandler struct is an http.Handler that serves the counters of the Collectors registered
+// to it (such as Loggers created with log.New(), or gRPC Log Servers and Clients) in the Prometheus
+// text format. Each registered Collector is labeled with its name:
+//
+//	GET /metrics
+//	# HELP zlog_events_total Events written by the logger, by level.
+//	# TYPE zlog_events_total counter
+//	zlog_events_total{name="api",level="info"} 42
+type MetricsHandler struct {
+	mu         sync.Mutex
+	collectors map[string]log.Collector
+}
+
+// NewMetricsHandler function will create a MetricsHandler with the input Collectors registered
+// by name. Collectors can also be registered later on with its Register() method
+func NewMetricsHandler(collectors map[string]log.Collector) *MetricsHandler {
+	h := &MetricsHandler{
+		collectors: map[string]log.Collector{},
+	}
+
+	for name, c := range collectors {
+		h.Register(name, c)
+	}
+
+	return h
+}
+
+// Register method will add the input Collector to the MetricsHandler as `name`, replacing any
+// Collector registered with the same name
+func (h *MetricsHandler) Register(name string, c log.Collector) {
+	if c == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.collectors[name] = c
+}
+
+// Unregister method will remove the Collector registered as `name` from the MetricsHandler
+func (h *MetricsHandler) Unregister(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.collectors, name)
+}
+
+// ServeHTTP method implements the http.Handler interface
+func (h *MetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, ErrMethod.Error(), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	buf := bufio.NewWriter(w)
+	writeCounters(buf, h.collect())
+
+	_ = buf.Flush() // deliberately ignore error in this method call
+}
+
+// collect method returns the Counters of all registered Collectors (sorted by name), labeling
+// each Counter with its Collector's name
+func (h *MetricsHandler) collect() []log.Counter {
+	h.mu.Lock()
+
+	names := make([]string, 0, len(h.collectors))
+
+	for name := range h.collectors {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	collectors := make([]log.Collector, len(names))
+
+	for idx, name := range names {
+		collectors[idx] = h.collectors[name]
+	}
+
+	h.mu.Unlock()
+
+	var counters []log.Counter
+
+	for idx, c := range collectors {
+		for _, counter := range c.Collect() {
+			labels := make(map[string]string, len(counter.Labels)+1)
+
+			for k, v := range counter.Labels {
+				labels[k] = v
+			}
+
+			labels["name"] = names[idx]
+			counter.Labels = labels
+
+			counters = append(counters, counter)
+		}
+	}
+
+	return counters
+}
+
+// writeCounters function writes the input Counters in the Prometheus text format, grouping them
+// by name (in order of appearance) under a single HELP and TYPE header
+func writeCounters(w *bufio.Writer, counters []log.Counter) {
+	var (
+		order  []string
+		groups = map[string][]log.Counter{}
+	)
+
+	for _, c := range counters {
+		if _, ok := groups[c.Name]; !ok {
+			order = append(order, c.Name)
+		}
+
+		groups[c.Name] = append(groups[c.Name], c)
+	}
+
+	for _, name := range order {
+		group := groups[name]
+
+		if help := group[0].Help; help != "" {
+			w.WriteString("# HELP " + name + " " + escapeHelp(help) + "\n")
+		}
+
+		w.WriteString("# TYPE " + name + " counter\n")
+
+		for _, c := range group {
+			w.WriteString(name)
+			writeLabels(w, c.Labels)
+			w.WriteString(" " + strconv.FormatUint(c.Value, 10) + "\n")
+		}
+	}
+}
+
+// writeLabels function writes the input labels as `{name="...",key="value"}`, with the name label
+// first and the remaining ones sorted by key
+func writeLabels(w *bufio.Writer, labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(labels))
+
+	for k := range labels {
+		if k != "name" {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	if _, ok := labels["name"]; ok {
+		keys = append([]string{"name"}, keys...)
+	}
+
+	w.WriteByte('{')
+
+	for idx, k := range keys {
+		if idx > 0 {
+			w.WriteByte(',')
+		}
+
+		w.WriteString(k + `="` + escapeLabel(labels[k]) + `"`)
+	}
+
+	w.WriteByte('}')
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string  { return helpEscaper.Replace(s) }
+func escapeLabel(s string) string { return labelEscaper.Replace(s) }
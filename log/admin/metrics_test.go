@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log"
+)
+
+type testCollector []log.Counter
+
+func (c testCollector) Collect() []log.Counter { return c }
+
+func TestMetricsHandler(t *testing.T) {
+	module := "MetricsHandler"
+	funcname := "ServeHTTP()"
+
+	api := log.New(log.WithOut(new(bytes.Buffer)))
+	api.Info("null")
+	api.Warn("null")
+
+	h := NewMetricsHandler(map[string]log.Collector{
+		"api": api.(log.Collector),
+	})
+	h.Register("db", api.(log.Collector))
+	h.Unregister("db")
+	h.Register("custom", testCollector{
+		{Name: "custom_total", Help: "A \\ counter\nwith two lines.", Labels: map[string]string{"zone": `eu "west"`, "app": "x"}, Value: 7},
+	})
+
+	type test struct {
+		name   string
+		method string
+		status int
+		wants  []string
+		skips  []string
+	}
+
+	var tests = []test{
+		{
+			name:   "serve counters",
+			method: http.MethodGet,
+			status: http.StatusOK,
+			wants: []string{
+				"# HELP zlog_events_total Events written by the logger, by level.\n# TYPE zlog_events_total counter\n",
+				`zlog_events_total{name="api",level="info"} 1` + "\n",
+				`zlog_events_total{name="api",level="warn"} 1` + "\n",
+				`zlog_write_errors_total{name="api"} 0` + "\n",
+				"# HELP custom_total A \\\\ counter\\nwith two lines.\n",
+				`custom_total{name="custom",app="x",zone="eu \"west\""} 7` + "\n",
+			},
+			skips: []string{`name="db"`},
+		},
+		{
+			name:   "invalid method",
+			method: http.MethodPost,
+			status: http.StatusMethodNotAllowed,
+			wants:  []string{ErrMethod.Error()},
+		},
+	}
+
+	for idx, test := range tests {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(test.method, "/metrics", nil))
+
+		if w.Code != test.status {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] status mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.status, w.Code, test.name)
+			continue
+		}
+
+		body := w.Body.String()
+
+		if test.status == http.StatusOK && w.Header().Get("Content-Type") != contentType {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected content type: %s -- action: %s", idx, module, funcname, w.Header().Get("Content-Type"), test.name)
+			continue
+		}
+
+		for _, s := range test.wants {
+			if !strings.Contains(body, s) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] missing %q in output: %s -- action: %s", idx, module, funcname, s, body, test.name)
+				return
+			}
+		}
+
+		for _, s := range test.skips {
+			if strings.Contains(body, s) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected %q in output: %s -- action: %s", idx, module, funcname, s, body, test.name)
+				return
+			}
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
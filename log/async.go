@@ -0,0 +1,350 @@
+package log
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+type overflowMode int
+
+const (
+	overflowBlock overflowMode = iota
+	overflowDropNewest
+	overflowDropOldest
+	overflowDropBelow
+)
+
+// OverflowPolicy struct defines how an asynchronous Logger behaves when its queue is full
+type OverflowPolicy struct {
+	mode  overflowMode
+	level event.Level
+}
+
+var (
+	// OverflowBlock will block the caller until there is room in the queue
+	OverflowBlock = OverflowPolicy{mode: overflowBlock}
+	// OverflowDropNewest will drop the incoming event when the queue is full
+	OverflowDropNewest = OverflowPolicy{mode: overflowDropNewest}
+	// OverflowDropOldest will drop the oldest queued event to make room for the incoming event
+	OverflowDropOldest = OverflowPolicy{mode: overflowDropOldest}
+)
+
+// OverflowDropBelow function will create an OverflowPolicy that drops incoming events below
+// the input level when the queue is full, while blocking the caller for events of the same
+// level or above
+func OverflowDropBelow(level event.Level) OverflowPolicy {
+	return OverflowPolicy{mode: overflowDropBelow, level: level}
+}
+
+// AsyncStats struct contains the counters of an asynchronous Logger's queue
+type AsyncStats struct {
+	Enqueued uint64
+	Dropped  uint64
+	Written  uint64
+	Queued   int
+}
+
+// AsyncLogger interface describes a Logger that can write its events asynchronously, and
+// that allows flushing its queue and retrieving its counters.
+//
+// Loggers created with New() implement this interface regardless of being set as asynchronous
+// or not; in the latter case these methods are no-ops.
+type AsyncLogger interface {
+	Logger
+
+	Flush(ctx context.Context) error
+	Close() error
+	Stats() AsyncStats
+}
+
+// LCAsync struct is a custom LoggerConfig to make new Loggers asynchronous
+type LCAsync struct {
+	size   int
+	policy OverflowPolicy
+}
+
+// Apply method will set the configured queue size and overflow policy to the input pointer to a LoggerBuilder
+func (c *LCAsync) Apply(lb *LoggerBuilder) {
+	lb.AsyncSize = c.size
+	lb.AsyncPolicy = c.policy
+}
+
+// WithAsync function will allow creating a LoggerConfig that makes a Logger asynchronous -- its events
+// are pushed into a bounded queue (of `size` events), and are formatted and written by a separate goroutine.
+//
+// The input OverflowPolicy defines the Logger's behavior when its queue is full.
+//
+// Fatal and panic events are always written synchronously, after flushing the queue. The returned Logger
+// implements the AsyncLogger interface, which exposes Flush(), Close() and Stats() methods:
+//
+//	logger := log.New(log.WithAsync(1024, log.OverflowDropOldest))
+//	defer logger.(log.AsyncLogger).Close()
+func WithAsync(size int, policy OverflowPolicy) LoggerConfig {
+	if size <= 0 {
+		return nil
+	}
+
+	return &LCAsync{
+		size:   size,
+		policy: policy,
+	}
+}
+
+// asyncQueue struct is a bounded ring buffer of events, consumed by a single goroutine
+type asyncQueue struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	idle     *sync.Cond
+
+	buf     []*event.Event
+	head    int
+	count   int
+	busy    bool
+	closed  bool
+	stopped chan struct{}
+
+	policy OverflowPolicy
+	write  func(m *event.Event)
+
+	enqueued uint64
+	dropped  uint64
+	written  uint64
+}
+
+func newAsyncQueue(size int, policy OverflowPolicy, write func(m *event.Event)) *asyncQueue {
+	q := &asyncQueue{
+		buf:     make([]*event.Event, size),
+		stopped: make(chan struct{}),
+		policy:  policy,
+		write:   write,
+	}
+
+	q.notEmpty = sync.NewCond(&q.mu)
+	q.notFull = sync.NewCond(&q.mu)
+	q.idle = sync.NewCond(&q.mu)
+
+	go q.run()
+
+	return q
+}
+
+// run method is the queue's consumer goroutine, writing queued events until the
+// queue is closed and drained
+func (q *asyncQueue) run() {
+	defer close(q.stopped)
+
+	for {
+		q.mu.Lock()
+
+		for q.count == 0 && !q.closed {
+			q.notEmpty.Wait()
+		}
+
+		if q.count == 0 && q.closed {
+			q.idle.Broadcast()
+			q.mu.Unlock()
+			return
+		}
+
+		m := q.pop()
+		q.busy = true
+		q.notFull.Signal()
+		q.mu.Unlock()
+
+		q.write(m)
+		atomic.AddUint64(&q.written, 1)
+
+		q.mu.Lock()
+		q.busy = false
+
+		if q.count == 0 {
+			q.idle.Broadcast()
+		}
+
+		q.mu.Unlock()
+	}
+}
+
+// pop method removes the oldest event from the queue. It must be called while holding the lock
+func (q *asyncQueue) pop() *event.Event {
+	m := q.buf[q.head]
+	q.buf[q.head] = nil
+	q.head = (q.head + 1) % len(q.buf)
+	q.count--
+
+	return m
+}
+
+// push method adds an event to the queue, applying the overflow policy if it is full
+func (q *asyncQueue) push(m *event.Event) (n int, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return 0, ErrClosed
+	}
+
+	for q.count == len(q.buf) {
+		switch q.policy.mode {
+		case overflowDropNewest:
+			atomic.AddUint64(&q.dropped, 1)
+			return 0, nil
+		case overflowDropOldest:
+			q.pop()
+			atomic.AddUint64(&q.dropped, 1)
+		case overflowDropBelow:
+			if m.GetLevel().Int() < q.policy.level.Int() {
+				atomic.AddUint64(&q.dropped, 1)
+				return 0, nil
+			}
+			q.notFull.Wait()
+		default:
+			q.notFull.Wait()
+		}
+
+		if q.closed {
+			return 0, ErrClosed
+		}
+	}
+
+	q.buf[(q.head+q.count)%len(q.buf)] = m
+	q.count++
+	atomic.AddUint64(&q.enqueued, 1)
+	q.notEmpty.Signal()
+
+	return 1, nil
+}
+
+// flush method blocks until the queue is drained, or until the input context is done
+func (q *asyncQueue) flush(ctx context.Context) error {
+	var (
+		drained = make(chan struct{})
+		stop    = make(chan struct{})
+	)
+
+	go func() {
+		defer close(drained)
+
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		for q.count > 0 || q.busy {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			q.idle.Wait()
+		}
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		// wake the waiting goroutine, so that it exits on the stop signal
+		close(stop)
+
+		q.mu.Lock()
+		q.idle.Broadcast()
+		q.mu.Unlock()
+
+		<-drained
+
+		return ctx.Err()
+	}
+}
+
+// close method stops accepting new events, and blocks until the queued events are written
+func (q *asyncQueue) close() error {
+	q.mu.Lock()
+
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+
+	q.closed = true
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+	q.mu.Unlock()
+
+	<-q.stopped
+	return nil
+}
+
+func (q *asyncQueue) stats() AsyncStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return AsyncStats{
+		Enqueued: atomic.LoadUint64(&q.enqueued),
+		Dropped:  atomic.LoadUint64(&q.dropped),
+		Written:  atomic.LoadUint64(&q.written),
+		Queued:   q.count,
+	}
+}
+
+// Flush method will block until all queued events are written, or until the input context is done.
+//
+// It is a no-op if the Logger is not asynchronous
+func (l *logger) Flush(ctx context.Context) error {
+	if l.async == nil {
+		return nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return l.async.flush(ctx)
+}
+
+// Stats method returns the counters of an asynchronous Logger's queue
+//
+// It returns an empty AsyncStats if the Logger is not asynchronous
+func (l *logger) Stats() AsyncStats {
+	if l.async == nil {
+		return AsyncStats{}
+	}
+
+	return l.async.stats()
+}
+
+// Flush method will flush all of the multiLogger's asynchronous Loggers, returning the first
+// error encountered
+func (l *multiLogger) Flush(ctx context.Context) error {
+	var err error
+
+	for _, logger := range l.loggers {
+		if a, ok := logger.(AsyncLogger); ok {
+			if ferr := a.Flush(ctx); ferr != nil && err == nil {
+				err = ferr
+			}
+		}
+	}
+
+	return err
+}
+
+// Stats method returns the sum of the counters of all of the multiLogger's asynchronous Loggers
+func (l *multiLogger) Stats() AsyncStats {
+	var stats AsyncStats
+
+	for _, logger := range l.loggers {
+		if a, ok := logger.(AsyncLogger); ok {
+			s := a.Stats()
+			stats.Enqueued += s.Enqueued
+			stats.Dropped += s.Dropped
+			stats.Written += s.Written
+			stats.Queued += s.Queued
+		}
+	}
+
+	return stats
+}
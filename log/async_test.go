@@ -0,0 +1,282 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// gatedWriter blocks all writes until its gate is opened
+type gatedWriter struct {
+	mu   sync.Mutex
+	gate chan struct{}
+	buf  bytes.Buffer
+}
+
+func newGatedWriter() *gatedWriter {
+	return &gatedWriter{gate: make(chan struct{})}
+}
+
+func (w *gatedWriter) Write(p []byte) (n int, err error) {
+	<-w.gate
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.buf.Write(p)
+}
+
+func (w *gatedWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.buf.String()
+}
+
+func TestWithAsync(t *testing.T) {
+	module := "Logger"
+	funcname := "WithAsync()"
+
+	type test struct {
+		name     string
+		policy   OverflowPolicy
+		events   []*event.Event
+		wants    []string
+		skips    []string
+		enqueued uint64
+		dropped  uint64
+		written  uint64
+	}
+
+	var newEvent = func(level event.Level, msg string) *event.Event {
+		return event.New().Level(level).Message(msg).Build()
+	}
+
+	var tests = []test{
+		{
+			name:   "drop newest events",
+			policy: OverflowDropNewest,
+			events: []*event.Event{
+				newEvent(event.Level_info, "a"),
+				newEvent(event.Level_info, "b"),
+				newEvent(event.Level_info, "c"),
+				newEvent(event.Level_info, "d"),
+				newEvent(event.Level_info, "e"),
+			},
+			wants:    []string{"a\n", "b\n", "c\n"},
+			skips:    []string{"d\n", "e\n"},
+			enqueued: 3,
+			dropped:  2,
+			written:  3,
+		},
+		{
+			name:   "drop oldest events",
+			policy: OverflowDropOldest,
+			events: []*event.Event{
+				newEvent(event.Level_info, "a"),
+				newEvent(event.Level_info, "b"),
+				newEvent(event.Level_info, "c"),
+				newEvent(event.Level_info, "d"),
+				newEvent(event.Level_info, "e"),
+			},
+			wants:    []string{"a\n", "d\n", "e\n"},
+			skips:    []string{"b\n", "c\n"},
+			enqueued: 5,
+			dropped:  2,
+			written:  3,
+		},
+		{
+			name:   "drop events below warn",
+			policy: OverflowDropBelow(event.Level_warn),
+			events: []*event.Event{
+				newEvent(event.Level_info, "a"),
+				newEvent(event.Level_info, "b"),
+				newEvent(event.Level_info, "c"),
+				newEvent(event.Level_debug, "d"),
+				newEvent(event.Level_info, "e"),
+			},
+			wants:    []string{"a\n", "b\n", "c\n"},
+			skips:    []string{"d\n", "e\n"},
+			enqueued: 3,
+			dropped:  2,
+			written:  3,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		w := newGatedWriter()
+		logger := New(WithOut(w), SkipExit, CfgTextOnly, WithAsync(2, test.policy)).(AsyncLogger)
+
+		// first event is held by the consumer goroutine, blocked on the writer
+		logger.Log(test.events[0])
+
+		for logger.Stats().Queued != 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		logger.Log(test.events[1:]...)
+
+		close(w.gate)
+
+		if err := logger.Close(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error closing the logger: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		out := w.String()
+
+		for _, want := range test.wants {
+			if !strings.Contains(out, want) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] expected %q in %q -- action: %s", idx, module, funcname, want, out, test.name)
+				return
+			}
+		}
+
+		for _, skip := range test.skips {
+			if strings.Contains(out, skip) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected %q in %q -- action: %s", idx, module, funcname, skip, out, test.name)
+				return
+			}
+		}
+
+		stats := logger.Stats()
+
+		if stats.Enqueued != test.enqueued || stats.Dropped != test.dropped || stats.Written != test.written {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected counters: %+v -- action: %s", idx, module, funcname, stats, test.name)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestAsyncFlushAndClose(t *testing.T) {
+	module := "Logger"
+	funcname := "Flush()"
+
+	w := newGatedWriter()
+	logger := New(WithOut(w), SkipExit, CfgTextOnly, WithAsync(16, OverflowBlock)).(AsyncLogger)
+
+	for i := 0; i < 10; i++ {
+		logger.Info("event")
+	}
+
+	// flush times out while the writer is blocked
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := logger.Flush(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("[%s] [%s] expected a deadline exceeded error; got %v", module, funcname, err)
+	}
+
+	close(w.gate)
+
+	if err := logger.Flush(context.Background()); err != nil {
+		t.Errorf("[%s] [%s] unexpected error: %v", module, funcname, err)
+	}
+
+	if n := strings.Count(w.String(), "event\n"); n != 10 {
+		t.Errorf("[%s] [%s] expected 10 events after flushing; got %v", module, funcname, n)
+	}
+
+	// fatal events are written synchronously
+	logger.Fatal("fatal")
+
+	if !strings.HasSuffix(w.String(), "fatal\n") {
+		t.Errorf("[%s] [%s] expected the fatal event to be written synchronously; got %q", module, funcname, w.String())
+	}
+
+	_ = logger.Close()
+
+	if n, err := logger.Output(event.New().Message("closed").Build()); n != 0 || !errors.Is(err, ErrClosed) {
+		t.Errorf("[%s] [Close()] expected ErrClosed after closing the logger; got %v, %v", module, n, err)
+	}
+
+	// non-async loggers implement the same methods as no-ops
+	sync := New(WithOut(new(bytes.Buffer)), SkipExit).(AsyncLogger)
+
+	if sync.Flush(context.Background()) != nil || sync.Close() != nil || sync.Stats() != (AsyncStats{}) {
+		t.Errorf("[%s] [%s] expected no-op methods on a synchronous logger", module, funcname)
+	}
+
+	if WithAsync(0, OverflowBlock) != nil {
+		t.Errorf("[%s] [WithAsync()] expected a nil config for a zero-sized queue", module)
+	}
+}
+
+func TestAsyncFlushTimeout(t *testing.T) {
+	module := "Logger"
+	funcname := "Flush()"
+
+	w := newGatedWriter()
+	logger := New(WithOut(w), SkipExit, CfgTextOnly, WithAsync(16, OverflowBlock)).(AsyncLogger)
+	defer logger.Close()
+	defer close(w.gate)
+
+	logger.Info("event")
+
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 20; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+
+		if err := logger.Flush(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected a deadline exceeded error; got %v -- action: %s", 0, module, funcname, err, "stop waiting once the context is done")
+			cancel()
+			return
+		}
+
+		cancel()
+	}
+
+	if after := runtime.NumGoroutine(); after > before+2 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected no leaked goroutines; got %v before and %v after -- action: %s", 0, module, funcname, before, after, "stop waiting once the context is done")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "stop waiting once the context is done")
+}
+
+func TestMultiLoggerAsync(t *testing.T) {
+	module := "MultiLogger"
+	funcname := "Close()"
+
+	buf1 := new(bytes.Buffer)
+	buf2 := new(bytes.Buffer)
+
+	logger := MultiLogger(
+		New(WithOut(buf1), SkipExit, CfgTextOnly, WithAsync(8, OverflowBlock)),
+		New(WithOut(buf2), SkipExit, CfgTextOnly),
+	).(AsyncLogger)
+
+	logger.Info("event")
+
+	if err := logger.Close(); err != nil {
+		t.Errorf("[%s] [%s] unexpected error: %v", module, funcname, err)
+	}
+
+	if buf1.String() != "event\n" || buf2.String() != "event\n" {
+		t.Errorf("[%s] [%s] expected both loggers to write the event; got %q and %q", module, funcname, buf1.String(), buf2.String())
+	}
+
+	if stats := logger.Stats(); stats.Enqueued != 1 || stats.Written != 1 {
+		t.Errorf("[%s] [Stats()] unexpected counters: %+v", module, stats)
+	}
+}
@@ -0,0 +1,94 @@
+package log
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/log/trace"
+)
+
+const maxCallerDepth int = 32
+
+// callerSkip lists the function name prefixes of the frames that events pass through before reaching
+// a Logger: this package, its adapters, the logging libraries they adapt and the standard library's
+// log packages (as used by RedirectStdLog() and slog's default handler)
+var callerSkip = []string{
+	"github.com/zalgonoise/zlog/log.",
+	"github.com/zalgonoise/zlog/log/zslog.",
+	"github.com/zalgonoise/zlog/log/zzap.",
+	"github.com/zalgonoise/zlog/log/zlogrus.",
+	"github.com/zalgonoise/zlog/log/zzerolog.",
+	"log.",
+	"log/internal.",
+	"log/slog.",
+	"go.uber.org/zap.",
+	"go.uber.org/zap/zapcore.",
+	"github.com/sirupsen/logrus.",
+	"github.com/rs/zerolog.",
+}
+
+// LCCaller struct is a custom LoggerConfig to add caller information to the events written by new Loggers
+type LCCaller struct {
+	skip []string
+}
+
+// Apply method will enable caller information in the input pointer to a LoggerBuilder, adding
+// the configured function name prefixes to its skip list
+func (c *LCCaller) Apply(lb *LoggerBuilder) {
+	lb.Caller = true
+	lb.CallerSkip = append(lb.CallerSkip, c.skip...)
+}
+
+// WithCaller function will allow creating a LoggerConfig that adds the source location (file, line
+// and function) of the logging call to each event written by a Logger, unless it already has one.
+//
+// The frames within this package (Logger methods, MultiLoggers, LineWriters and package-level
+// functions like log.Info()) are skipped automatically, as are the ones within the adapters for
+// slog, zap, logrus and zerolog (and within these libraries), and within the standard library's log
+// package. Wrappers around a Logger can add their own function name prefixes (such as
+// `github.com/org/project/logging.`) to be skipped as well:
+//
+//	logger := log.New(log.WithCaller("github.com/org/project/logging."))
+func WithCaller(skip ...string) LoggerConfig {
+	return &LCCaller{
+		skip: skip,
+	}
+}
+
+// callerFrame method returns the first frame in the call stack outside of this package (and
+// of the Logger's skip list), as an event.Caller
+func (l *logger) callerFrame() *event.Caller {
+	var pcs [maxCallerDepth]uintptr
+
+	// skip runtime.Callers and callerFrame
+	n := runtime.Callers(2, pcs[:])
+
+	for _, f := range trace.Frames(pcs[:n]) {
+		if !l.skipFrame(f) {
+			return event.NewCallerFrom(f.File, f.Line, f.Function)
+		}
+	}
+
+	return nil
+}
+
+// skipFrame method returns whether the input frame is within one of the packages in callerSkip
+// (except for their tests), or matches one of the Logger's skip list prefixes
+func (l *logger) skipFrame(f trace.Frame) bool {
+	if !strings.HasSuffix(f.File, "_test.go") {
+		for _, prefix := range callerSkip {
+			if strings.HasPrefix(f.Function, prefix) {
+				return true
+			}
+		}
+	}
+
+	for _, prefix := range l.callerSkip {
+		if strings.HasPrefix(f.Function, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
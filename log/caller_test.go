@@ -0,0 +1,189 @@
+package log
+
+import (
+	"bytes"
+	stdlog "log"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestWithCaller(t *testing.T) {
+	module := "Logger"
+	funcname := "WithCaller()"
+
+	type test struct {
+		name string
+		call func(l Logger) int
+	}
+
+	// each call returns the line it was issued from
+	var tests = []test{
+		{
+			name: "Logger.Info()",
+			call: func(l Logger) int {
+				_, _, line, _ := runtime.Caller(0)
+				l.Info("null")
+				return line + 1
+			},
+		},
+		{
+			name: "Logger.Printf()",
+			call: func(l Logger) int {
+				_, _, line, _ := runtime.Caller(0)
+				l.Printf("%s", "null")
+				return line + 1
+			},
+		},
+		{
+			name: "Logger.Log()",
+			call: func(l Logger) int {
+				_, _, line, _ := runtime.Caller(0)
+				l.Log(event.New().Message("null").Build())
+				return line + 1
+			},
+		},
+		{
+			name: "MultiLogger Logger.Warn()",
+			call: func(l Logger) int {
+				ml := MultiLogger(l, New(NilConfig))
+
+				_, _, line, _ := runtime.Caller(0)
+				ml.Warn("null")
+				return line + 1
+			},
+		},
+		{
+			name: "FanOutLogger Logger.Warn()",
+			call: func(l Logger) int {
+				fl := FanOutLogger(0, l, New(NilConfig))
+				defer fl.Close()
+
+				_, _, line, _ := runtime.Caller(0)
+				fl.Warn("null")
+				return line + 1
+			},
+		},
+		{
+			name: "FanOutLogger child Logger.Log()",
+			call: func(l Logger) int {
+				fl := FanOutLogger(0, New(NilConfig), l)
+				defer fl.Close()
+
+				child := fl.With(map[string]interface{}{"a": 1})
+
+				_, _, line, _ := runtime.Caller(0)
+				child.Log(event.New().Message("null").Build())
+				return line + 1
+			},
+		},
+		{
+			name: "RedirectStdLog() with the standard library's log.Print()",
+			call: func(l Logger) int {
+				restore := RedirectStdLog(l, event.Level_info)
+				defer restore()
+
+				_, _, line, _ := runtime.Caller(0)
+				stdlog.Print("null")
+				return line + 1
+			},
+		},
+		{
+			name: "NewStdLogger() Printf()",
+			call: func(l Logger) int {
+				stdLogger := NewStdLogger(l, event.Level_info)
+
+				_, _, line, _ := runtime.Caller(0)
+				stdLogger.Printf("%s", "null")
+				return line + 1
+			},
+		},
+		{
+			name: "package-level Info()",
+			call: func(l Logger) int {
+				prev := std
+				std = l
+				defer func() { std = prev }()
+
+				_, _, line, _ := runtime.Caller(0)
+				Info("null")
+				return line + 1
+			},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		var caller *event.Caller
+
+		buf := new(bytes.Buffer)
+		logger := New(WithOut(buf), SkipExit, WithCaller(), WithHook(func(m *event.Event) bool {
+			caller = m.GetCaller()
+			return true
+		}))
+
+		line := test.call(logger)
+
+		if caller == nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected caller information -- action: %s", idx, module, funcname, test.name)
+			return
+		}
+
+		if !strings.HasSuffix(caller.GetFile(), "caller_test.go") || caller.GetLine() != int32(line) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] location mismatch: wanted caller_test.go:%v ; got %s -- action: %s", idx, module, funcname, line, caller.Short(), test.name)
+			return
+		}
+
+		if !strings.Contains(buf.String(), "[log/caller_test.go:") {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected caller in the output: %q -- action: %s", idx, module, funcname, buf.String(), test.name)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+// wrappedInfo simulates a logging wrapper, to be skipped with WithCaller()
+func wrappedInfo(l Logger, msg string) {
+	l.Info(msg)
+}
+
+func TestWithCallerSkip(t *testing.T) {
+	module := "Logger"
+	funcname := "WithCaller()"
+
+	var caller *event.Caller
+
+	logger := New(WithOut(new(bytes.Buffer)), SkipExit, WithCaller(callerSkip[0]+"wrappedInfo"), WithHook(func(m *event.Event) bool {
+		caller = m.GetCaller()
+		return true
+	}))
+
+	wrappedInfo(logger, "null")
+
+	if !strings.HasSuffix(caller.GetFunction(), "TestWithCallerSkip") {
+		t.Errorf("[%s] [%s] expected the wrapper to be skipped; got %s", module, funcname, caller.GetFunction())
+	}
+
+	// events without caller information by default
+	caller = nil
+	New(WithOut(new(bytes.Buffer)), SkipExit, WithHook(func(m *event.Event) bool {
+		caller = m.GetCaller()
+		return true
+	})).Info("null")
+
+	if caller != nil {
+		t.Errorf("[%s] [%s] unexpected caller information: %v", module, funcname, caller)
+	}
+}
@@ -0,0 +1,104 @@
+package log
+
+// With method returns a child Logger with the input metadata fields merged on top of this
+// Logger's own (the input fields take precedence).
+//
+// It is a shortcut for `Logger.Child("", "", fields)`
+func (l *logger) With(fields map[string]interface{}) Logger {
+	return l.Child("", "", fields)
+}
+
+// Child method returns a new Logger which inherits this Logger's prefix, sub-prefix and metadata
+// fields, replacing the prefix and sub-prefix with the input ones (if not empty), and merging the
+// input fields on top of the inherited metadata.
+//
+// The child Logger shares its parent's level filter, hooks, redactor, sampler, deduper, asynchronous
+// queue and Scope settings; however its prefix, sub-prefix and fields are its own. Calling Prefix(),
+// Sub() or Fields() on either Logger does not affect the other, and logging through the child does
+// not take the parent's lock. Changing the level on either of them (with Leveler.SetLevel()) applies
+// to both, as the filter is shared.
+//
+// The parent's io.Writer, formatter and sinks are copied when the child is created: the child writes
+// to the same outputs, but calling SetOuts() or AddOuts() on either Logger afterwards does not affect
+// the other. Derive new children once the outputs are changed.
+//
+// Closing a child Logger only syncs the outputs shared with its parent and rejects further writes
+// to the child; it is up to the parent Logger to close them
+func (l *logger) Child(prefix, sub string, fields map[string]interface{}) Logger {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	child := &logger{
+		parent:     l.root(),
+		out:        l.out,
+		prefix:     l.prefix,
+		sub:        l.sub,
+		fmt:        l.fmt,
+		meta:       merge(l.meta, fields),
+		skipExit:   l.skipExit,
+		sampler:    l.sampler,
+		dedup:      l.dedup,
+		hooks:      l.hooks,
+		async:      l.async,
+		sinks:      l.sinks,
+		redactor:   l.redactor,
+		caller:     l.caller,
+		callerSkip: l.callerSkip,
+		scopes:     l.scopes,
+		scope:      l.scope,
+	}
+
+	if prefix != "" {
+		child.prefix = prefix
+	}
+
+	if sub != "" {
+		child.sub = sub
+	}
+
+	return child
+}
+
+// root method returns the Logger that holds the level filter and write lock shared by this
+// Logger's children; which is the Logger itself if it is not a child
+func (l *logger) root() *logger {
+	if l.parent != nil {
+		return l.parent
+	}
+	return l
+}
+
+// merge function returns a new map with the entries in fields set on top of the ones in meta
+func merge(meta, fields map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(meta)+len(fields))
+
+	for k, v := range meta {
+		out[k] = v
+	}
+
+	for k, v := range fields {
+		out[k] = v
+	}
+
+	return out
+}
+
+// With method is similar to a Logger.With() method, however the multiLogger will return a
+// new MultiLogger of its Loggers' children, each one with the input metadata fields merged
+// on top of their own
+func (l *multiLogger) With(fields map[string]interface{}) Logger {
+	return l.Child("", "", fields)
+}
+
+// Child method is similar to a Logger.Child() method, however the multiLogger will return a
+// new MultiLogger of its Loggers' children, created with the same input prefix, sub-prefix
+// and metadata fields
+func (l *multiLogger) Child(prefix, sub string, fields map[string]interface{}) Logger {
+	loggers := make([]Logger, 0, len(l.loggers))
+
+	for _, logger := range l.loggers {
+		loggers = append(loggers, logger.Child(prefix, sub, fields))
+	}
+
+	return MultiLogger(loggers...)
+}
@@ -0,0 +1,265 @@
+package log
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestChild(t *testing.T) {
+	module := "Logger"
+	funcname := "Child()"
+
+	type test struct {
+		name   string
+		prefix string
+		sub    string
+		fields map[string]interface{}
+		wants  *event.Event
+	}
+
+	var tests = []test{
+		{
+			name: "inherit all elements",
+			wants: event.New().
+				Prefix("parent").
+				Sub("parent-sub").
+				Metadata(map[string]interface{}{"a": "parent"}).
+				Build(),
+		},
+		{
+			name:   "replace the prefix and sub-prefix",
+			prefix: "child",
+			sub:    "child-sub",
+			wants: event.New().
+				Prefix("child").
+				Sub("child-sub").
+				Metadata(map[string]interface{}{"a": "parent"}).
+				Build(),
+		},
+		{
+			name:   "merge and override fields",
+			fields: map[string]interface{}{"a": "child", "b": true},
+			wants: event.New().
+				Prefix("parent").
+				Sub("parent-sub").
+				Metadata(map[string]interface{}{"a": "child", "b": true}).
+				Build(),
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		buf := new(bytes.Buffer)
+
+		parent := New(WithOut(buf), SkipExit, CfgFormatProtobuf).
+			Prefix("parent").
+			Sub("parent-sub").
+			Fields(map[string]interface{}{"a": "parent"})
+
+		child := parent.Child(test.prefix, test.sub, test.fields)
+		child.Info("null")
+
+		m := decodeEvent(t, buf.Bytes())
+
+		if m.GetPrefix() != test.wants.GetPrefix() || m.GetSub() != test.wants.GetSub() {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] prefix mismatch: wanted [%s/%s] ; got [%s/%s] -- action: %s", idx, module, funcname, test.wants.GetPrefix(), test.wants.GetSub(), m.GetPrefix(), m.GetSub(), test.name)
+			return
+		}
+
+		if !reflect.DeepEqual(m.Metadata(), test.wants.Metadata()) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] metadata mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants.Metadata(), m.Metadata(), test.name)
+			return
+		}
+
+		// the parent is not affected by the child
+		buf.Reset()
+		parent.Info("null")
+		m = decodeEvent(t, buf.Bytes())
+
+		if m.GetPrefix() != "parent" || m.GetSub() != "parent-sub" {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] parent prefix was modified: got [%s/%s] -- action: %s", idx, module, funcname, m.GetPrefix(), m.GetSub(), test.name)
+			return
+		}
+
+		if !reflect.DeepEqual(m.Metadata(), map[string]interface{}{"a": "parent"}) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] parent metadata was modified: got %v -- action: %s", idx, module, funcname, m.Metadata(), test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestWith(t *testing.T) {
+	module := "Logger"
+	funcname := "With()"
+
+	buf := new(bytes.Buffer)
+
+	parent := New(WithOut(buf), SkipExit, CfgFormatProtobuf).
+		Fields(map[string]interface{}{"a": 1})
+
+	child := parent.With(map[string]interface{}{"b": 2})
+	grandchild := child.With(map[string]interface{}{"c": 3}).Prefix("grandchild")
+
+	grandchild.Info("null")
+
+	m := decodeEvent(t, buf.Bytes())
+	wants := map[string]interface{}{"a": int64(1), "b": int64(2), "c": int64(3)}
+
+	if !reflect.DeepEqual(m.Metadata(), wants) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] metadata mismatch: wanted %v ; got %v -- action: %s", 0, module, funcname, wants, m.Metadata(), "merge fields across generations")
+		return
+	}
+
+	if m.GetPrefix() != "grandchild" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] prefix mismatch: wanted %s ; got %s -- action: %s", 0, module, funcname, "grandchild", m.GetPrefix(), "merge fields across generations")
+		return
+	}
+
+	buf.Reset()
+	child.Info("null")
+
+	m = decodeEvent(t, buf.Bytes())
+	wants = map[string]interface{}{"a": int64(1), "b": int64(2)}
+
+	if !reflect.DeepEqual(m.Metadata(), wants) || m.GetPrefix() != "log" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] child was modified: got [%s] %v -- action: %s", 1, module, funcname, m.GetPrefix(), m.Metadata(), "grandchild does not affect child")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "merge fields across generations")
+}
+
+func TestChildSharedLevel(t *testing.T) {
+	module := "Logger"
+	funcname := "Child()"
+
+	buf := new(bytes.Buffer)
+
+	parent := New(WithOut(buf), SkipExit, CfgTextOnly)
+	child := parent.With(map[string]interface{}{"a": true})
+
+	parent.(Leveler).SetLevel(event.Level_error)
+
+	if level := child.(Leveler).Level(); level != event.Level_error {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] level mismatch: wanted %v ; got %v -- action: %s", 0, module, funcname, event.Level_error, level, "share the parent's level filter")
+		return
+	}
+
+	child.Info("null")
+
+	if buf.Len() > 0 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected output: %q -- action: %s", 0, module, funcname, buf.String(), "share the parent's level filter")
+		return
+	}
+
+	child.(Leveler).SetLevel(event.Level_trace)
+	parent.Debug("null")
+
+	if buf.Len() == 0 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected output from the parent -- action: %s", 1, module, funcname, "share the child's level filter")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "share the level filter")
+}
+
+func TestChildOutputs(t *testing.T) {
+	module := "Logger"
+	funcname := "Child()"
+
+	var (
+		first  = new(bytes.Buffer)
+		second = new(bytes.Buffer)
+	)
+
+	parent := New(WithOut(first), SkipExit, CfgTextOnly)
+	child := parent.Child("", "", nil)
+
+	parent.SetOuts(second)
+	child.Info("child")
+	parent.Info("parent")
+
+	if first.String() != "child\n" || second.String() != "parent\n" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the child to keep its outputs; got %q and %q -- action: %s", 0, module, funcname, first.String(), second.String(), "snapshot the parent's outputs")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "snapshot the parent's outputs")
+}
+
+func TestChildConcurrent(t *testing.T) {
+	module := "Logger"
+	funcname := "Child()"
+
+	buf := new(bytes.Buffer)
+	parent := New(WithOut(buf), SkipExit, CfgTextOnly)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			child := parent.Child("", "worker", map[string]interface{}{"id": i})
+			for j := 0; j < 50; j++ {
+				child.Info("null")
+				parent.Info("null")
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	lines := strings.Count(buf.String(), "\n")
+	if lines != 8*50*2 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] line count mismatch: wanted %v ; got %v -- action: %s", 0, module, funcname, 8*50*2, lines, "write concurrently to a shared writer")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "write concurrently to a shared writer")
+}
+
+func TestMultiLoggerChild(t *testing.T) {
+	module := "MultiLogger"
+	funcname := "Child()"
+
+	buf1 := new(bytes.Buffer)
+	buf2 := new(bytes.Buffer)
+
+	ml := MultiLogger(
+		New(WithOut(buf1), SkipExit, CfgFormatProtobuf),
+		New(WithOut(buf2), SkipExit, CfgFormatProtobuf),
+	)
+
+	ml.Child("child", "", map[string]interface{}{"a": true}).Info("null")
+
+	for idx, buf := range []*bytes.Buffer{buf1, buf2} {
+		m := decodeEvent(t, buf.Bytes())
+
+		if m.GetPrefix() != "child" || !reflect.DeepEqual(m.Metadata(), map[string]interface{}{"a": true}) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: got [%s] %v -- action: %s", idx, module, funcname, m.GetPrefix(), m.Metadata(), "derive children from all loggers")
+			return
+		}
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "derive children from all loggers")
+}
+
+func decodeEvent(t *testing.T, b []byte) *event.Event {
+	m, err := event.Decode(b)
+	if err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	return m
+}
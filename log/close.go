@@ -0,0 +1,252 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sync/atomic"
+)
+
+var (
+	// ErrClosed is returned by the write methods of a Logger after it is closed
+	ErrClosed error = errors.New("logger is closed")
+)
+
+// Logger states, as set in its `closed` field. A closing Logger rejects new events, while it writes
+// its pending ones (such as the Deduper's and Sampler's summaries) to its outputs
+const (
+	loggerOpen int32 = iota
+	loggerClosing
+	loggerClosed
+)
+
+// syncer interface describes an io.Writer that commits its buffered data on request, such as an
+// *os.File
+type syncer interface {
+	Sync() error
+}
+
+// writerGroup interface describes an io.Writer that duplicates its writes to a set of io.Writers,
+// such as a store.MultiWriter; whose io.Writers are synced and closed individually
+type writerGroup interface {
+	Writers() []io.Writer
+}
+
+// isClosed method returns true if either the Logger or its root Logger are closed (or closing)
+func (l *logger) isClosed() bool {
+	return atomic.LoadInt32(&l.closed) != loggerOpen || atomic.LoadInt32(&l.root().closed) != loggerOpen
+}
+
+// isDone method returns true if the root Logger's outputs are closed, after which not even the
+// Logger's own events are written
+func (l *logger) isDone() bool {
+	return atomic.LoadInt32(&l.root().closed) == loggerClosed
+}
+
+// writers method returns the Logger's io.Writer followed by its Sinks' io.Writers, with the
+// io.Writers of any writerGroup in their place
+func (l *logger) writers() []io.Writer {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var w = make([]io.Writer, 0, len(l.sinks)+1)
+
+	w = appendWriters(w, l.out)
+
+	for _, s := range l.sinks {
+		w = appendWriters(w, s.out)
+	}
+
+	return w
+}
+
+// appendWriters function appends the input io.Writer to the input slice, or the io.Writers it
+// groups if it is a writerGroup
+func appendWriters(writers []io.Writer, w io.Writer) []io.Writer {
+	g, ok := w.(writerGroup)
+	if !ok {
+		return append(writers, w)
+	}
+
+	for _, inner := range g.Writers() {
+		writers = appendWriters(writers, inner)
+	}
+
+	return writers
+}
+
+// Sync method will block until the Logger's asynchronous queue (if any) is written, and then commit
+// the data written to its io.Writers (and its Sinks' io.Writers) which implement a `Sync() error`
+// method, such as logfiles. The standard output and error streams are skipped.
+//
+// It returns the errors encountered, wrapped as one
+func (l *logger) Sync() error {
+	var errs []error
+
+	if l.async != nil {
+		if err := l.async.flush(context.Background()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	var synced []interface{}
+
+	for _, w := range l.writers() {
+		s, ok := w.(syncer)
+		if !ok || isStdStream(w) || contains(synced, w) {
+			continue
+		}
+
+		synced = append(synced, w)
+
+		if err := s.Sync(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return wrapErrors("syncing", errs)
+}
+
+// Close method will close the Logger, after which any writes to it will return ErrClosed. Closing a
+// Logger more than once is a no-op.
+//
+// A Logger created with New() writes the summaries of its Deduper and Sampler (if any) and stops
+// their timers, then stops its asynchronous queue (if any) and waits for the queued events to be
+// written. Then, it syncs and closes its io.Writers and Sinks which implement io.Closer (except
+// for the standard output and error streams), and the io.Closers registered with WithCloser().
+//
+// Closing a child Logger only syncs the outputs shared with its parent, and rejects further writes
+// to the child; it is up to the parent Logger to close them
+func (l *logger) Close() error {
+	if !atomic.CompareAndSwapInt32(&l.closed, loggerOpen, loggerClosing) {
+		return nil
+	}
+
+	defer atomic.StoreInt32(&l.closed, loggerClosed)
+
+	if l.parent != nil {
+		return l.Sync()
+	}
+
+	if l.dedup != nil {
+		l.dedup.Flush()
+	}
+
+	if l.sampler != nil {
+		l.sampler.Flush()
+	}
+
+	var errs []error
+
+	if l.async != nil {
+		if err := l.async.close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := l.Sync(); err != nil {
+		errs = append(errs, err)
+	}
+
+	var closers []io.Closer
+
+	for _, w := range l.writers() {
+		if c, ok := w.(io.Closer); ok && !isStdStream(w) {
+			closers = append(closers, c)
+		}
+	}
+
+	l.closeOnce.Do(func() {
+		closers = append(closers, l.closers...)
+	})
+
+	var closed []interface{}
+
+	for _, c := range closers {
+		if contains(closed, c) {
+			continue
+		}
+
+		closed = append(closed, c)
+
+		if err := c.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return wrapErrors("closing", errs)
+}
+
+// Sync method will sync all of the multiLogger's Loggers, returning the errors encountered wrapped
+// as one
+func (l *multiLogger) Sync() error {
+	var errs []error
+
+	for _, logger := range l.loggers {
+		if err := logger.Sync(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return wrapErrors("syncing", errs)
+}
+
+// Close method will close all of the multiLogger's Loggers, regardless of errors, returning the
+// errors encountered wrapped as one
+func (l *multiLogger) Close() error {
+	var errs []error
+
+	for _, logger := range l.loggers {
+		if err := logger.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return wrapErrors("closing", errs)
+}
+
+// isStdStream function returns true if the input io.Writer is the standard output or error stream,
+// which are not synced nor closed by Loggers
+func isStdStream(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && (f == os.Stdout || f == os.Stderr)
+}
+
+// contains function returns true if the input value is already in the input slice. Values of types
+// which are not comparable are never matched (the same io.Writer may be set both as a Logger's output
+// and as an io.Closer, for instance)
+func contains(values []interface{}, v interface{}) bool {
+	if t := reflect.TypeOf(v); t == nil || !t.Comparable() {
+		return false
+	}
+
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wrapErrors function returns nil if there are no errors in the input slice, the error itself if
+// there is only one, or a single error encapsulating all of them
+func wrapErrors(action string, errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+
+	var err = fmt.Errorf("multiple errors when %s: %w", action, errs[0])
+
+	for _, e := range errs[1:] {
+		err = fmt.Errorf("%w ; %v", err, e)
+	}
+
+	return err
+}
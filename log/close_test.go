@@ -0,0 +1,332 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/store"
+	"github.com/zalgonoise/zlog/store/fs"
+)
+
+type testSyncCloser struct {
+	bytes.Buffer
+	synced int32
+	closed int32
+}
+
+func (w *testSyncCloser) Sync() error {
+	atomic.AddInt32(&w.synced, 1)
+	return nil
+}
+
+func (w *testSyncCloser) Close() error {
+	atomic.AddInt32(&w.closed, 1)
+	return nil
+}
+
+func TestLoggerSync(t *testing.T) {
+	module := "Logger"
+	funcname := "Sync()"
+
+	type test struct {
+		name   string
+		confs  func(out, sink *testSyncCloser) []LoggerConfig
+		synced int32
+	}
+
+	var tests = []test{
+		{
+			name: "sync the output",
+			confs: func(out, sink *testSyncCloser) []LoggerConfig {
+				return []LoggerConfig{WithOut(out)}
+			},
+			synced: 1,
+		},
+		{
+			name: "sync the output and sinks of an asynchronous logger",
+			confs: func(out, sink *testSyncCloser) []LoggerConfig {
+				return []LoggerConfig{WithOut(out), WithSink(sink, nil, event.Level_trace), WithAsync(16, OverflowBlock)}
+			},
+			synced: 2,
+		},
+		{
+			name: "sync a shared writer once",
+			confs: func(out, sink *testSyncCloser) []LoggerConfig {
+				return []LoggerConfig{WithOut(out), WithSink(out, FormatJSON, event.Level_trace)}
+			},
+			synced: 1,
+		},
+		{
+			name: "skip the standard streams",
+			confs: func(out, sink *testSyncCloser) []LoggerConfig {
+				return []LoggerConfig{WithOut(os.Stderr), WithSink(os.Stdout, nil, event.Level_error)}
+			},
+			synced: 0,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		out, sink := new(testSyncCloser), new(testSyncCloser)
+		logger := New(append(test.confs(out, sink), CfgTextOnly)...)
+
+		logger.Info("null")
+
+		if err := logger.Sync(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		if synced := atomic.LoadInt32(&out.synced) + atomic.LoadInt32(&sink.synced); synced != test.synced {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v syncs; got %v -- action: %s", idx, module, funcname, test.synced, synced, test.name)
+			return
+		}
+
+		if test.synced > 0 && !strings.Contains(out.String(), "null") {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] the event was not written before syncing: %q -- action: %s", idx, module, funcname, out.String(), test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestLoggerClose(t *testing.T) {
+	module := "Logger"
+	funcname := "Close()"
+
+	out, sink, closer := new(testSyncCloser), new(testSyncCloser), new(testCloser)
+
+	logger := New(
+		WithOut(out),
+		WithSink(sink, nil, event.Level_warn),
+		WithCloser(closer, out),
+		WithAsync(16, OverflowBlock),
+		CfgTextOnly,
+	)
+	child := logger.With(map[string]interface{}{"a": 1})
+
+	child.Info("before")
+
+	if err := child.Close(); err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 0, module, funcname, err, "close a child logger")
+		return
+	}
+
+	if _, err := child.Output(event.New().Message("null").Build()); !errors.Is(err, ErrClosed) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v -- action: %s", 0, module, funcname, err, "close a child logger")
+		return
+	}
+
+	if atomic.LoadInt32(&out.closed) != 0 || !strings.Contains(out.String(), "before") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the parent's output to be flushed and open: %q -- action: %s", 0, module, funcname, out.String(), "close a child logger")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "close a child logger")
+
+	logger.Info("after")
+
+	for i := 0; i < 2; i++ {
+		if err := logger.Close(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 1, module, funcname, err, "close the parent logger")
+			return
+		}
+	}
+
+	if !strings.Contains(out.String(), "after") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] the queue was not written before closing: %q -- action: %s", 1, module, funcname, out.String(), "close the parent logger")
+		return
+	}
+
+	for _, closed := range []int32{atomic.LoadInt32(&out.closed), atomic.LoadInt32(&sink.closed), atomic.LoadInt32(&closer.closed)} {
+		if closed != 1 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected outputs and closers to be closed once; got %v -- action: %s", 1, module, funcname, closed, "close the parent logger")
+			return
+		}
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "close the parent logger")
+
+	for _, l := range []Logger{logger, logger.With(map[string]interface{}{"b": 2})} {
+		if n, err := l.Write([]byte("null")); n != 0 || !errors.Is(err, ErrClosed) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v, %v -- action: %s", 2, module, funcname, n, err, "write after closing")
+			return
+		}
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 2, module, funcname, "write after closing")
+}
+
+func TestMultiLoggerClose(t *testing.T) {
+	module := "MultiLogger"
+	funcname := "Close()"
+
+	outs := []*testSyncCloser{{}, {}}
+
+	logger := MultiLogger(
+		New(WithOut(outs[0])),
+		New(WithOut(outs[1]), WithAsync(16, OverflowBlock)),
+	)
+
+	if err := logger.Sync(); err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 0, module, funcname, err, "sync all loggers")
+		return
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 0, module, funcname, err, "close all loggers")
+		return
+	}
+
+	for idx, out := range outs {
+		if atomic.LoadInt32(&out.synced) != 2 || atomic.LoadInt32(&out.closed) != 1 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] logger #%v was synced %v times and closed %v times -- action: %s", 0, module, funcname, idx, out.synced, out.closed, "close all loggers")
+			return
+		}
+	}
+
+	if _, err := logger.Output(event.New().Message("null").Build()); !errors.Is(err, ErrClosed) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v -- action: %s", 1, module, funcname, err, "write after closing")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "close all loggers")
+}
+
+func TestLoggerCloseLogfiles(t *testing.T) {
+	module := "Logger"
+	funcname := "Close()"
+
+	type test struct {
+		name   string
+		logger func(files []*fs.Logfile) Logger
+	}
+
+	var tests = []test{
+		{
+			name: "logfiles set with SetOuts()",
+			logger: func(files []*fs.Logfile) Logger {
+				return New(CfgTextOnly).SetOuts(os.Stderr, files[0], files[1])
+			},
+		},
+		{
+			name: "logfiles added with AddOuts()",
+			logger: func(files []*fs.Logfile) Logger {
+				return New(WithOut(files[0]), CfgTextOnly).AddOuts(files[1])
+			},
+		},
+		{
+			name: "logfiles set with WithOut()",
+			logger: func(files []*fs.Logfile) Logger {
+				return New(WithOut(files[0], files[1]), CfgTextOnly)
+			},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		dir := t.TempDir()
+		files := make([]*fs.Logfile, 2)
+
+		for i := range files {
+			f, err := fs.New(filepath.Join(dir, fmt.Sprintf("test-%v.log", i)))
+			if err != nil {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error creating a logfile: %v -- action: %s", idx, module, funcname, err, test.name)
+				return
+			}
+
+			files[i] = f
+		}
+
+		logger := test.logger(files)
+		logger.Info("null")
+
+		if err := logger.Close(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		for i, f := range files {
+			if _, err := f.Write([]byte("null")); !errors.Is(err, store.ErrClosed) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] expected logfile #%v to be closed; got %v -- action: %s", idx, module, funcname, i, err, test.name)
+				return
+			}
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestLoggerCloseSummaries(t *testing.T) {
+	module := "Logger"
+	funcname := "Close()"
+
+	type test struct {
+		name  string
+		conf  LoggerConfig
+		wants string
+	}
+
+	var tests = []test{
+		{
+			name:  "flush the deduper",
+			conf:  WithDedup(50 * time.Millisecond),
+			wants: "message repeated 2 times",
+		},
+		{
+			name:  "flush the sampler",
+			conf:  WithSampler(NewSampler(50*time.Millisecond, 1, 0)),
+			wants: "sampled out 2 events",
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		out := new(testSyncCloser)
+		logger := New(WithOut(out), test.conf, CfgTextOnly)
+
+		for i := 0; i < 3; i++ {
+			logger.Info("null")
+		}
+
+		if err := logger.Close(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		closed := out.String()
+
+		if !strings.Contains(closed, test.wants) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected the summary to be written on close: %q -- action: %s", idx, module, funcname, closed, test.name)
+			return
+		}
+
+		// the timers are stopped, and nothing is written after closing
+		time.Sleep(100 * time.Millisecond)
+
+		if after := out.String(); after != closed {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected write after closing: %q -- action: %s", idx, module, funcname, after[len(closed):], test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
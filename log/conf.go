@@ -144,9 +144,12 @@ func (c LCFilter) Apply(lb *LoggerBuilder) {
 }
 
 // Apply method will set the input LoggerBuilder's outputs and format to the LCDatabase object's.
+//
+// The database writer is also registered as an io.Closer, to be closed before the Logger exits
 func (c *LCDatabase) Apply(lb *LoggerBuilder) {
 	lb.Out = c.Out
 	lb.Fmt = c.Fmt
+	lb.Closers = append(lb.Closers, c.Out)
 }
 
 // NilLogger function will create a minimal LoggerConfig with an empty writer, and that does not
@@ -185,7 +188,7 @@ func WithOut(out ...io.Writer) LoggerConfig {
 
 	if len(out) > 1 {
 		return &LCOut{
-			out: io.MultiWriter(out...),
+			out: store.MultiWriter(out...),
 		}
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/zalgonoise/zlog/log/event"
 	"github.com/zalgonoise/zlog/log/format/protobuf"
+	"github.com/zalgonoise/zlog/store"
 	"github.com/zalgonoise/zlog/store/db"
 )
 
@@ -231,7 +232,7 @@ func TestOut(t *testing.T) {
 		{
 			name:  "test multi writers",
 			outs:  []io.Writer{os.Stdout, os.Stderr},
-			wants: &LCOut{out: io.MultiWriter(os.Stdout, os.Stderr)},
+			wants: &LCOut{out: store.MultiWriter(os.Stdout, os.Stderr)},
 		},
 	}
 
@@ -0,0 +1,553 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/zalgonoise/zlog/grpc/client"
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/log/format/json"
+	"github.com/zalgonoise/zlog/log/format/text"
+	"github.com/zalgonoise/zlog/store/db/mongo"
+	"github.com/zalgonoise/zlog/store/db/mysql"
+	"github.com/zalgonoise/zlog/store/db/postgres"
+	"github.com/zalgonoise/zlog/store/db/sqlite"
+	"github.com/zalgonoise/zlog/store/fs"
+)
+
+// formats maps the formatter names to the LogFormatters without builder options
+var formats = map[string]log.LogFormatter{
+	"text":     log.FormatText,
+	"json":     log.FormatJSON,
+	"csv":      log.FormatCSV,
+	"xml":      log.FormatXML,
+	"gob":      log.FormatGob,
+	"bson":     log.FormatBSON,
+	"protobuf": log.FormatProtobuf,
+}
+
+// timestamps maps the text formatter's time format names to their LogTimestamp
+var timestamps = map[string]text.LogTimestamp{
+	"rfc3339nano": text.LTRFC3339Nano,
+	"rfc3339":     text.LTRFC3339,
+	"rfc822z":     text.LTRFC822Z,
+	"rubydate":    text.LTRubyDate,
+	"unix_nano":   text.LTUnixNano,
+	"unix_milli":  text.LTUnixMilli,
+	"unix_micro":  text.LTUnixMicro,
+}
+
+// option struct pairs an Output's key with whether it is set, to reject options which do not
+// apply to its type
+type option struct {
+	key string
+	set bool
+}
+
+// New function loads the configuration document in the input path, applies the `ZLOG_*`
+// environment variables to it and builds its Logger.
+//
+// If the path is empty, the one in the ZLOG_CONFIG environment variable is used; and if that
+// is not set either, the Logger is built from the environment variables alone
+func New(path string) (log.Logger, error) {
+	if path == "" {
+		path = os.Getenv(EnvConfig)
+	}
+
+	if path == "" {
+		cfg := new(Config)
+
+		if err := cfg.ApplyEnv(); err != nil {
+			return nil, err
+		}
+
+		return cfg.Build()
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.Build()
+}
+
+// Validate method checks the values in the Config, without opening any outputs. The returned
+// error is a *KeyError naming the offending key, like `loggers[0].outputs[1].path`
+func (c *Config) Validate() error {
+	for idx, l := range c.Loggers {
+		if err := l.validate(fmt.Sprintf("loggers[%v]", idx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Build method validates the Config and builds its Logger, opening its outputs. A Config with
+// several Loggers is built into a MultiLogger, and an empty one into the default Logger. If an
+// output fails to open, the outputs opened so far are closed before returning the error.
+//
+// Errors raised by the gRPC outputs' clients once they are running are written to stderr
+func (c *Config) Build() (log.Logger, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(c.Loggers) == 0 {
+		return log.New(), nil
+	}
+
+	loggers := make([]log.Logger, 0, len(c.Loggers))
+
+	for idx, l := range c.Loggers {
+		logger, err := l.build(fmt.Sprintf("loggers[%v]", idx))
+		if err != nil {
+			// close the outputs of the Loggers built so far
+			for _, built := range loggers {
+				_ = built.Close() // deliberately ignore error in this method call
+			}
+
+			return nil, err
+		}
+
+		loggers = append(loggers, logger)
+	}
+
+	if len(loggers) == 1 {
+		return loggers[0], nil
+	}
+
+	return log.MultiLogger(loggers...), nil
+}
+
+func (l Logger) validate(key string) error {
+	if _, err := level(l.Level); err != nil {
+		return &KeyError{Key: key + ".level", Err: err}
+	}
+
+	if l.Format != nil {
+		if err := l.Format.validate(key + ".format"); err != nil {
+			return err
+		}
+	}
+
+	for idx, o := range l.Outputs {
+		if err := o.validate(fmt.Sprintf("%s.outputs[%v]", key, idx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l Logger) build(key string) (log.Logger, error) {
+	var (
+		confs   []log.LoggerConfig
+		writers []io.Writer
+		closers []io.Closer
+	)
+
+	if l.Prefix != "" {
+		confs = append(confs, log.WithPrefix(l.Prefix))
+	}
+
+	if l.Sub != "" {
+		confs = append(confs, log.WithSub(l.Sub))
+	}
+
+	if l.Level != "" {
+		lv, _ := level(l.Level) // validated
+		confs = append(confs, log.WithFilter(lv))
+	}
+
+	if l.SkipExit {
+		confs = append(confs, log.SkipExit)
+	}
+
+	if l.Format != nil {
+		confs = append(confs, log.WithFormat(l.Format.build()))
+	}
+
+	for idx, o := range l.Outputs {
+		okey := fmt.Sprintf("%s.outputs[%v]", key, idx)
+
+		w, err := o.open(okey)
+		if err != nil {
+			// close the outputs opened so far
+			for _, c := range closers {
+				_ = c.Close() // deliberately ignore error in this method call
+			}
+
+			return nil, err
+		}
+
+		// files, databases and gRPC clients are closed with the Logger, or before it exits
+		if c, ok := w.(io.Closer); ok && o.Type != "stderr" && o.Type != "stdout" {
+			closers = append(closers, c)
+		}
+
+		// outputs without their own format and level use the Logger's writer; others are Sinks
+		if o.Format == nil && o.Level == "" && o.isWriter() {
+			writers = append(writers, w)
+			continue
+		}
+
+		lv, _ := level(o.Level) // validated
+
+		var f log.LogFormatter
+
+		if o.Format != nil {
+			f = o.Format.build()
+		} else if !o.isWriter() {
+			f = log.FormatProtobuf
+		}
+
+		confs = append(confs, log.WithSink(w, f, lv))
+	}
+
+	if len(closers) > 0 {
+		confs = append(confs, log.WithCloser(closers...))
+	}
+
+	switch {
+	case len(writers) > 0:
+		confs = append(confs, log.WithOut(writers...))
+	case len(l.Outputs) > 0:
+		// all outputs are Sinks
+		confs = append(confs, log.WithOut(io.Discard))
+	}
+
+	logger := log.New(confs...)
+
+	// the fields are set in the root Logger, as only its Close() method closes the outputs
+	if len(l.Fields) > 0 {
+		logger.Fields(l.Fields)
+	}
+
+	return logger, nil
+}
+
+func (f Format) validate(key string) error {
+	if _, ok := formats[f.Type]; !ok {
+		return &KeyError{Key: key + ".type", Err: fmt.Errorf("%w: %q", ErrUnknownFormat, f.Type)}
+	}
+
+	var opts []option
+
+	switch f.Type {
+	case "text":
+		if _, ok := timestamps[strings.ToLower(f.Time)]; f.Time != "" && !ok {
+			return &KeyError{Key: key + ".time", Err: fmt.Errorf("%w: %q", ErrInvalidTime, f.Time)}
+		}
+
+		opts = []option{{"indent", f.Indent}, {"skip_newline", f.SkipNewline}}
+	case "json":
+		opts = []option{
+			{"time", f.Time != ""},
+			{"level_first", f.LevelFirst},
+			{"double_space", f.DoubleSpace},
+			{"color", f.Color},
+			{"upper", f.Upper},
+			{"no_timestamp", f.NoTimestamp},
+			{"no_headers", f.NoHeaders},
+			{"no_level", f.NoLevel},
+		}
+	default:
+		opts = []option{
+			{"time", f.Time != ""},
+			{"level_first", f.LevelFirst},
+			{"double_space", f.DoubleSpace},
+			{"color", f.Color},
+			{"upper", f.Upper},
+			{"no_timestamp", f.NoTimestamp},
+			{"no_headers", f.NoHeaders},
+			{"no_level", f.NoLevel},
+			{"indent", f.Indent},
+			{"skip_newline", f.SkipNewline},
+		}
+	}
+
+	return unsupported(key, f.Type+" format", opts...)
+}
+
+// build method creates the LogFormatter, applying the builder options for text and JSON
+func (f Format) build() log.LogFormatter {
+	switch f.Type {
+	case "text":
+		b := text.New()
+
+		if f.Time != "" {
+			b.Time(timestamps[strings.ToLower(f.Time)])
+		}
+		if f.LevelFirst {
+			b.LevelFirst()
+		}
+		if f.DoubleSpace {
+			b.DoubleSpace()
+		}
+		if f.Color {
+			b.Color()
+		}
+		if f.Upper {
+			b.Upper()
+		}
+		if f.NoTimestamp {
+			b.NoTimestamp()
+		}
+		if f.NoHeaders {
+			b.NoHeaders()
+		}
+		if f.NoLevel {
+			b.NoLevel()
+		}
+
+		return b.Build()
+	case "json":
+		return &json.FmtJSON{
+			SkipNewline: f.SkipNewline,
+			Indent:      f.Indent,
+		}
+	default:
+		return formats[f.Type]
+	}
+}
+
+func (o Output) validate(key string) error {
+	if _, err := level(o.Level); err != nil {
+		return &KeyError{Key: key + ".level", Err: err}
+	}
+
+	if o.Format != nil {
+		if !o.isWriter() {
+			return &KeyError{Key: key + ".format", Err: fmt.Errorf("%w by the %s output, which always uses protobuf", ErrNotSupported, o.Type)}
+		}
+
+		if err := o.Format.validate(key + ".format"); err != nil {
+			return err
+		}
+	}
+
+	var (
+		required []option
+		opts     []option
+	)
+
+	switch o.Type {
+	case "stderr", "stdout":
+	case "file":
+		if o.MaxSize < 0 {
+			return &KeyError{Key: key + ".max_size", Err: fmt.Errorf("%w: %v is not a size in megabytes", ErrInvalidValue, o.MaxSize)}
+		}
+
+		required = []option{{"path", o.Path != ""}}
+	case "sqlite":
+		required = []option{{"path", o.Path != ""}}
+	case "postgres":
+		required = []option{{"address", o.Address != ""}, {"port", o.Port != ""}, {"database", o.Database != ""}}
+	case "mysql":
+		required = []option{{"address", o.Address != ""}, {"database", o.Database != ""}}
+	case "mongo":
+		required = []option{{"address", o.Address != ""}, {"database", o.Database != ""}, {"collection", o.Collection != ""}}
+	case "grpc":
+		required = []option{{"addresses", len(o.Addresses) > 0}}
+
+		if o.TLS != nil {
+			if o.Insecure {
+				return &KeyError{Key: key + ".tls", Err: fmt.Errorf("%w: cannot be set with insecure", ErrInvalidValue)}
+			}
+
+			if err := o.TLS.validate(key + ".tls"); err != nil {
+				return err
+			}
+		}
+	case "":
+		return &KeyError{Key: key + ".type", Err: ErrMissingValue}
+	default:
+		return &KeyError{Key: key + ".type", Err: fmt.Errorf("%w: %q", ErrUnknownOutput, o.Type)}
+	}
+
+	for _, r := range required {
+		if !r.set {
+			return &KeyError{Key: key + "." + r.key, Err: fmt.Errorf("%w for the %s output", ErrMissingValue, o.Type)}
+		}
+	}
+
+	// reject the options which do not apply to the output's type
+	for _, opt := range []option{
+		{"path", o.Path != ""},
+		{"max_size", o.MaxSize != 0},
+		{"address", o.Address != ""},
+		{"port", o.Port != ""},
+		{"database", o.Database != ""},
+		{"collection", o.Collection != ""},
+		{"addresses", len(o.Addresses) > 0},
+		{"unary", o.Unary},
+		{"insecure", o.Insecure},
+		{"tls", o.TLS != nil},
+		{"timing", o.Timing},
+	} {
+		if opt.set && !o.accepts(opt.key) {
+			opts = append(opts, opt)
+		}
+	}
+
+	return unsupported(key, o.Type+" output", opts...)
+}
+
+// accepts method returns whether the input key is an option for the Output's type
+func (o Output) accepts(key string) bool {
+	switch o.Type {
+	case "file":
+		return key == "path" || key == "max_size"
+	case "sqlite":
+		return key == "path"
+	case "postgres":
+		return key == "address" || key == "port" || key == "database"
+	case "mysql":
+		return key == "address" || key == "database"
+	case "mongo":
+		return key == "address" || key == "database" || key == "collection"
+	case "grpc":
+		return key == "addresses" || key == "unary" || key == "insecure" || key == "tls" || key == "timing"
+	default:
+		return false
+	}
+}
+
+// isWriter method returns whether the Output writes formatted events (as opposed to databases
+// and gRPC clients, which read protobuf-encoded events)
+func (o Output) isWriter() bool {
+	return o.Type == "stderr" || o.Type == "stdout" || o.Type == "file"
+}
+
+// open method creates the Output's io.Writer
+func (o Output) open(key string) (io.Writer, error) {
+	var (
+		w   io.Writer
+		err error
+	)
+
+	switch o.Type {
+	case "stderr":
+		return os.Stderr, nil
+	case "stdout":
+		return os.Stdout, nil
+	case "file":
+		var f *fs.Logfile
+
+		f, err = fs.New(o.Path)
+		if err == nil && o.MaxSize > 0 {
+			f = f.MaxSize(o.MaxSize)
+		}
+		w = f
+	case "sqlite":
+		w, err = sqlite.New(o.Path)
+	case "postgres":
+		w, err = postgres.New(o.Address, o.Port, o.Database)
+	case "mysql":
+		w, err = mysql.New(o.Address, o.Database)
+	case "mongo":
+		w, err = mongo.New(o.Address, o.Database, o.Collection)
+	case "grpc":
+		return o.dial(), nil
+	}
+
+	if err != nil {
+		return nil, &KeyError{Key: key, Err: fmt.Errorf("failed to open the %s output: %w", o.Type, err)}
+	}
+
+	return w, nil
+}
+
+// dial method creates the gRPC client for the Output, writing its errors to stderr
+func (o Output) dial() io.Writer {
+	confs := []client.LogClientConfig{client.WithAddr(o.Addresses...)}
+
+	if o.Unary {
+		confs = append(confs, client.UnaryRPC())
+	}
+
+	if o.Insecure {
+		confs = append(confs, client.Insecure())
+	}
+
+	if o.TLS != nil && o.TLS.Cert != "" {
+		confs = append(confs, client.WithTLS(o.TLS.CA, o.TLS.Cert, o.TLS.Key))
+	} else if o.TLS != nil {
+		confs = append(confs, client.WithTLS(o.TLS.CA))
+	}
+
+	if o.Timing {
+		confs = append(confs, client.WithTiming())
+	}
+
+	c, errCh := client.New(confs...)
+
+	go func() {
+		errLogger := log.New(log.WithOut(os.Stderr), log.WithPrefix("gRPC"), log.WithSub("client"), log.SkipExit)
+
+		for err := range errCh {
+			errLogger.Err(err, "gRPC output failed")
+		}
+	}()
+
+	return c
+}
+
+// validate method checks that the certificates are set in pairs and are readable, as the gRPC
+// client panics otherwise
+func (t TLS) validate(key string) error {
+	if t.CA == "" {
+		return &KeyError{Key: key + ".ca", Err: ErrMissingValue}
+	}
+
+	if (t.Cert == "") != (t.Key == "") {
+		if t.Cert == "" {
+			return &KeyError{Key: key + ".cert", Err: fmt.Errorf("%w when a key is set", ErrMissingValue)}
+		}
+
+		return &KeyError{Key: key + ".key", Err: fmt.Errorf("%w when a certificate is set", ErrMissingValue)}
+	}
+
+	for _, f := range []struct{ key, path string }{{"ca", t.CA}, {"cert", t.Cert}, {"key", t.Key}} {
+		if f.path == "" {
+			continue
+		}
+
+		if _, err := os.Stat(f.path); err != nil {
+			return &KeyError{Key: key + "." + f.key, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// level function returns the event.Level for the input name. An empty name returns the lowest
+// level (trace)
+func level(name string) (event.Level, error) {
+	if name == "" {
+		return event.Level_trace, nil
+	}
+
+	lv, ok := event.Level_value[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidLevel, name)
+	}
+
+	return event.Level(lv), nil
+}
+
+// unsupported function returns a KeyError for the first of the input options which is set
+func unsupported(key, kind string, opts ...option) error {
+	for _, opt := range opts {
+		if opt.set {
+			return &KeyError{Key: key + "." + opt.key, Err: fmt.Errorf("%w by the %s", ErrNotSupported, kind)}
+		}
+	}
+
+	return nil
+}
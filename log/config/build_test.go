@@ -0,0 +1,361 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestValidate(t *testing.T) {
+	module := "Config"
+	funcname := "Validate()"
+
+	type test struct {
+		name string
+		cfg  *Config
+		key  string
+		err  error
+	}
+
+	var tests = []test{
+		{
+			name: "valid config",
+			cfg: &Config{Loggers: []Logger{{
+				Level:  "warn",
+				Format: &Format{Type: "text", Time: "RFC3339", Color: true},
+				Outputs: []Output{
+					{Type: "stderr"},
+					{Type: "file", Path: "app.log", MaxSize: 10, Format: &Format{Type: "json", Indent: true}},
+					{Type: "postgres", Address: "localhost", Port: "5432", Database: "logs", Level: "error"},
+					{Type: "grpc", Addresses: []string{"localhost:9099"}, Insecure: true},
+				},
+			}}},
+		},
+		{
+			name: "invalid logger level",
+			cfg:  &Config{Loggers: []Logger{{}, {Level: "verbose"}}},
+			key:  "loggers[1].level",
+			err:  ErrInvalidLevel,
+		},
+		{
+			name: "unknown format",
+			cfg:  &Config{Loggers: []Logger{{Format: &Format{Type: "yaml"}}}},
+			key:  "loggers[0].format.type",
+			err:  ErrUnknownFormat,
+		},
+		{
+			name: "invalid time format",
+			cfg:  &Config{Loggers: []Logger{{Format: &Format{Type: "text", Time: "iso"}}}},
+			key:  "loggers[0].format.time",
+			err:  ErrInvalidTime,
+		},
+		{
+			name: "text option in a JSON format",
+			cfg:  &Config{Loggers: []Logger{{Format: &Format{Type: "json", Color: true}}}},
+			key:  "loggers[0].format.color",
+			err:  ErrNotSupported,
+		},
+		{
+			name: "missing output type",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Path: "app.log"}}}}},
+			key:  "loggers[0].outputs[0].type",
+			err:  ErrMissingValue,
+		},
+		{
+			name: "unknown output type",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "syslog"}}}}},
+			key:  "loggers[0].outputs[0].type",
+			err:  ErrUnknownOutput,
+		},
+		{
+			name: "missing file path",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "stderr"}, {Type: "file", MaxSize: 10}}}}},
+			key:  "loggers[0].outputs[1].path",
+			err:  ErrMissingValue,
+		},
+		{
+			name: "negative rotation size",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "file", Path: "app.log", MaxSize: -1}}}}},
+			key:  "loggers[0].outputs[0].max_size",
+			err:  ErrInvalidValue,
+		},
+		{
+			name: "option of another output type",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "stderr", Path: "app.log"}}}}},
+			key:  "loggers[0].outputs[0].path",
+			err:  ErrNotSupported,
+		},
+		{
+			name: "format in a database output",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "sqlite", Path: "app.db", Format: &Format{Type: "json"}}}}}},
+			key:  "loggers[0].outputs[0].format",
+			err:  ErrNotSupported,
+		},
+		{
+			name: "missing mongo collection",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "mongo", Address: "localhost:27017", Database: "logs"}}}}},
+			key:  "loggers[0].outputs[0].collection",
+			err:  ErrMissingValue,
+		},
+		{
+			name: "missing postgres port",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "postgres", Address: "localhost", Database: "logs"}}}}},
+			key:  "loggers[0].outputs[0].port",
+			err:  ErrMissingValue,
+		},
+		{
+			name: "invalid output level",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "stdout", Level: "loud"}}}}},
+			key:  "loggers[0].outputs[0].level",
+			err:  ErrInvalidLevel,
+		},
+		{
+			name: "gRPC certificate without a key",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "grpc", Addresses: []string{":9099"}, TLS: &TLS{CA: "ca.pem", Cert: "cert.pem"}}}}}},
+			key:  "loggers[0].outputs[0].tls.key",
+			err:  ErrMissingValue,
+		},
+		{
+			name: "unreadable gRPC certificate",
+			cfg:  &Config{Loggers: []Logger{{Outputs: []Output{{Type: "grpc", Addresses: []string{":9099"}, TLS: &TLS{CA: "/no/such/ca.pem"}}}}}},
+			key:  "loggers[0].outputs[0].tls.ca",
+			err:  os.ErrNotExist,
+		},
+	}
+
+	for idx, test := range tests {
+		err := test.cfg.Validate()
+
+		if test.err == nil {
+			if err != nil {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+				continue
+			}
+
+			t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+			continue
+		}
+
+		var kerr *KeyError
+
+		if !errors.Is(err, test.err) || !errors.As(err, &kerr) || kerr.Key != test.key {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v in %s ; got %v -- action: %s", idx, module, funcname, test.err, test.key, err, test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	module := "Config"
+	funcname := "Build()"
+
+	dir := t.TempDir()
+	all := filepath.Join(dir, "all.log")
+	warn := filepath.Join(dir, "warn.log")
+
+	cfg := &Config{Loggers: []Logger{{
+		Prefix:   "api",
+		Sub:      "http",
+		Level:    "info",
+		Fields:   map[string]interface{}{"region": "eu"},
+		SkipExit: true,
+		Format:   &Format{Type: "json"},
+		Outputs: []Output{
+			{Type: "file", Path: all, MaxSize: 10},
+			{Type: "file", Path: warn, Level: "warn", Format: &Format{Type: "text", NoTimestamp: true}},
+		},
+	}}}
+
+	logger, err := cfg.Build()
+	if err != nil {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 0, module, funcname, err, "build the logger")
+	}
+
+	logger.Debug("skipped")
+	logger.Info("request")
+	logger.Warn("slow request")
+
+	b, err := os.ReadFile(all)
+	if err != nil {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] failed to read output: %v -- action: %s", 1, module, funcname, err, "write JSON to the logger's file")
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+
+	if len(lines) != 2 ||
+		!strings.Contains(lines[0], `"service":"api"`) ||
+		!strings.Contains(lines[0], `"module":"http"`) ||
+		!strings.Contains(lines[0], `"message":"request"`) ||
+		!strings.Contains(lines[0], `"region":"eu"`) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected output: %q -- action: %s", 1, module, funcname, string(b), "write JSON to the logger's file")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "write JSON to the logger's file")
+
+	b, err = os.ReadFile(warn)
+	if err != nil {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] failed to read output: %v -- action: %s", 2, module, funcname, err, "write warnings as text to the sink's file")
+	}
+
+	if out := string(b); strings.Count(out, "\n") != 1 || !strings.Contains(out, "slow request") || strings.Contains(out, "{") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected output: %q -- action: %s", 2, module, funcname, out, "write warnings as text to the sink's file")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 2, module, funcname, "write warnings as text to the sink's file")
+
+	if leveler, ok := logger.(log.Leveler); !ok || leveler.Level() != event.Level_info {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected an info level filter -- action: %s", 3, module, funcname, "apply the level filter")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 3, module, funcname, "apply the level filter")
+}
+
+func TestNew(t *testing.T) {
+	module := "Config"
+	funcname := "New()"
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "zlog.yaml")
+	out := filepath.Join(dir, "out.log")
+
+	data := "loggers:\n  - prefix: api\n    format: json\n  - prefix: db\n    format: json\n"
+
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] failed to write file: %v -- action: %s", 0, module, funcname, err, "build a logger from ZLOG_CONFIG")
+	}
+
+	t.Setenv(EnvConfig, path)
+	t.Setenv("ZLOG_OUTPUT", "file:"+out)
+	t.Setenv("ZLOG_LOGGERS_1_LEVEL", "error")
+
+	logger, err := New("")
+	if err != nil {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 0, module, funcname, err, "build a logger from ZLOG_CONFIG")
+	}
+
+	logger.Info("null")
+
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] failed to read output: %v -- action: %s", 0, module, funcname, err, "build a logger from ZLOG_CONFIG")
+	}
+
+	if out := string(b); strings.Count(out, "\n") != 1 || !strings.Contains(out, `"service":"api"`) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected output: %q -- action: %s", 0, module, funcname, out, "build a logger from ZLOG_CONFIG")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "build a logger from ZLOG_CONFIG")
+}
+
+// openFiles function returns the number of file descriptors of the process which point to the
+// input path
+func openFiles(t *testing.T, path string) int {
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		t.Skipf("cannot list the open files: %v", err)
+	}
+
+	var n int
+
+	for _, e := range entries {
+		if target, err := os.Readlink(filepath.Join("/proc/self/fd", e.Name())); err == nil && target == path {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestBuildClose(t *testing.T) {
+	module := "Config"
+	funcname := "Build()"
+
+	if runtime.GOOS != "linux" {
+		t.Skip("open files are listed from /proc")
+	}
+
+	type test struct {
+		name string
+		cfg  func(path string) *Config
+		ok   bool
+	}
+
+	var tests = []test{
+		{
+			name: "close the outputs of a logger with fields",
+			cfg: func(path string) *Config {
+				return &Config{Loggers: []Logger{{
+					Fields:  map[string]interface{}{"region": "eu"},
+					Outputs: []Output{{Type: "file", Path: path}},
+				}}}
+			},
+			ok: true,
+		},
+		{
+			name: "close the opened outputs when a later output fails",
+			cfg: func(path string) *Config {
+				return &Config{Loggers: []Logger{{
+					Outputs: []Output{
+						{Type: "file", Path: path},
+						{Type: "file", Path: filepath.Join(path, "no", "such", "dir")},
+					},
+				}}}
+			},
+		},
+		{
+			name: "close the built loggers when a later logger fails",
+			cfg: func(path string) *Config {
+				return &Config{Loggers: []Logger{
+					{Outputs: []Output{{Type: "file", Path: path}}},
+					{Outputs: []Output{{Type: "file", Path: filepath.Join(path, "no", "such", "dir")}}},
+				}}
+			},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		path := filepath.Join(t.TempDir(), "out.log")
+
+		logger, err := test.cfg(path).Build()
+
+		if (err == nil) != test.ok {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected success: %v ; got error %v -- action: %s", idx, module, funcname, test.ok, err, test.name)
+			return
+		}
+
+		if logger != nil {
+			if n := openFiles(t, path); n != 1 {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] expected the file to be open; got %v descriptors -- action: %s", idx, module, funcname, n, test.name)
+				return
+			}
+
+			if err := logger.Close(); err != nil {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error on close: %v -- action: %s", idx, module, funcname, err, test.name)
+				return
+			}
+		}
+
+		if n := openFiles(t, path); n != 0 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected the file to be closed; got %v descriptors -- action: %s", idx, module, funcname, n, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
@@ -0,0 +1,282 @@
+// Package config builds Loggers from a declarative configuration document (in JSON or YAML),
+// with overrides from `ZLOG_*` environment variables; so that logging can be changed without
+// rebuilding the application:
+//
+//	loggers:
+//	  - prefix: api
+//	    level: info
+//	    fields:
+//	      region: eu-west-1
+//	    format:
+//	      type: text
+//	      color: true
+//	      level_first: true
+//	    outputs:
+//	      - type: stderr
+//	      - type: file
+//	        path: /var/log/api.log
+//	        max_size: 100
+//	        format: json
+//	      - type: postgres
+//	        address: db.local
+//	        port: "5432"
+//	        database: logs
+//	        level: warn
+//
+// The document is loaded with Load() (or ParseJSON() / ParseYAML()) and turned into a Logger
+// with its Build() method; or in one go with New():
+//
+//	logger, err := config.New("/etc/app/logging.yaml")
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrUnknownDocument error = errors.New("unsupported configuration document type -- use a .json, .yaml or .yml file")
+	ErrInvalidLevel    error = errors.New("invalid level")
+	ErrUnknownFormat   error = errors.New("unknown format")
+	ErrInvalidTime     error = errors.New("invalid time format")
+	ErrUnknownOutput   error = errors.New("unknown output type")
+	ErrMissingValue    error = errors.New("missing required value")
+	ErrInvalidValue    error = errors.New("invalid value")
+	ErrNotSupported    error = errors.New("option not supported")
+	ErrNoLogger        error = errors.New("no such logger")
+)
+
+// KeyError struct is the error returned when validating a Config, pointing at the key (or
+// environment variable) holding the offending value, such as `loggers[0].outputs[1].path`
+type KeyError struct {
+	Key string
+	Err error
+}
+
+// Error method implements the error interface
+func (e *KeyError) Error() string {
+	return e.Key + ": " + e.Err.Error()
+}
+
+// Unwrap method returns the underlying error, so that it can be matched with errors.Is()
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+// Config struct is the root of a configuration document, defining one or more Loggers.
+//
+// A Config with more than one Logger is built into a MultiLogger; while an empty Config
+// builds the default Logger
+type Config struct {
+	Loggers []Logger `json:"loggers,omitempty" yaml:"loggers,omitempty"`
+}
+
+// Logger struct defines a single Logger in a configuration document
+type Logger struct {
+	// Prefix and Sub set the Logger's prefix and sub-prefix
+	Prefix string `json:"prefix,omitempty" yaml:"prefix,omitempty"`
+	Sub    string `json:"sub,omitempty" yaml:"sub,omitempty"`
+
+	// Level is the minimum level for events to be written (trace, debug, info, warn, error,
+	// fatal or panic)
+	Level string `json:"level,omitempty" yaml:"level,omitempty"`
+
+	// Fields are added to the metadata of every event
+	Fields map[string]interface{} `json:"fields,omitempty" yaml:"fields,omitempty"`
+
+	// SkipExit makes fatal and panic events skip the os.Exit(1) and panic() calls
+	SkipExit bool `json:"skip_exit,omitempty" yaml:"skip_exit,omitempty"`
+
+	// Format is the formatter for the Logger's outputs, which defaults to colored text
+	Format *Format `json:"format,omitempty" yaml:"format,omitempty"`
+
+	// Outputs are the Logger's writers, which default to stderr
+	Outputs []Output `json:"outputs,omitempty" yaml:"outputs,omitempty"`
+}
+
+// Format struct defines a LogFormatter by its type, and the builder options for it.
+//
+// In a document, it can also be set to just the type's name (like `format: json`)
+type Format struct {
+	// Type is the name of the formatter: text, json, csv, xml, gob, bson or protobuf
+	Type string `json:"type" yaml:"type"`
+
+	// Time is the timestamp format for text: rfc3339nano (default), rfc3339, rfc822z,
+	// rubydate, unix_nano, unix_milli or unix_micro
+	Time string `json:"time,omitempty" yaml:"time,omitempty"`
+
+	// text formatter options
+	LevelFirst  bool `json:"level_first,omitempty" yaml:"level_first,omitempty"`
+	DoubleSpace bool `json:"double_space,omitempty" yaml:"double_space,omitempty"`
+	Color       bool `json:"color,omitempty" yaml:"color,omitempty"`
+	Upper       bool `json:"upper,omitempty" yaml:"upper,omitempty"`
+	NoTimestamp bool `json:"no_timestamp,omitempty" yaml:"no_timestamp,omitempty"`
+	NoHeaders   bool `json:"no_headers,omitempty" yaml:"no_headers,omitempty"`
+	NoLevel     bool `json:"no_level,omitempty" yaml:"no_level,omitempty"`
+
+	// JSON formatter options
+	Indent      bool `json:"indent,omitempty" yaml:"indent,omitempty"`
+	SkipNewline bool `json:"skip_newline,omitempty" yaml:"skip_newline,omitempty"`
+}
+
+// formatFields is an alias of Format without its decoding methods, to decode the object form
+type formatFields Format
+
+// UnmarshalJSON method implements the json.Unmarshaler interface, to accept either a
+// formatter's name or an object
+func (f *Format) UnmarshalJSON(b []byte) error {
+	var name string
+
+	if err := json.Unmarshal(b, &name); err == nil {
+		*f = Format{Type: name}
+		return nil
+	}
+
+	return json.Unmarshal(b, (*formatFields)(f))
+}
+
+// UnmarshalYAML method implements the yaml.Unmarshaler interface, to accept either a
+// formatter's name or a mapping
+func (f *Format) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*f = Format{Type: node.Value}
+		return nil
+	}
+
+	// a node's Decode() method does not reject unknown keys, like the document's decoder
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i < len(node.Content); i += 2 {
+			if key := node.Content[i]; !formatKeys[key.Value] {
+				return fmt.Errorf("line %d: field %s not found in type config.Format", key.Line, key.Value)
+			}
+		}
+	}
+
+	return node.Decode((*formatFields)(f))
+}
+
+// formatKeys lists the keys in a Format mapping
+var formatKeys = func() map[string]bool {
+	keys := map[string]bool{}
+	t := reflect.TypeOf(Format{})
+
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		keys[name] = true
+	}
+
+	return keys
+}()
+
+// TLS struct defines the certificates for a gRPC output. Setting only the CA certificate
+// uses TLS; setting the client certificate and key as well uses mutual TLS
+type TLS struct {
+	CA   string `json:"ca" yaml:"ca"`
+	Cert string `json:"cert,omitempty" yaml:"cert,omitempty"`
+	Key  string `json:"key,omitempty" yaml:"key,omitempty"`
+}
+
+// Output struct defines one of a Logger's writers. Which fields apply depends on its Type:
+//
+//   - stderr, stdout: no options
+//   - file: Path (required) and MaxSize, the rotation size in megabytes
+//   - sqlite: Path (required)
+//   - postgres: Address, Port and Database (all required)
+//   - mysql: Address and Database (both required)
+//   - mongo: Address, Database and Collection (all required)
+//   - grpc: Addresses (required), Unary, Insecure, TLS and Timing
+//
+// stderr, stdout and file outputs may set their own Format, otherwise using the Logger's. All
+// outputs may set a Level, only writing events of that level and above
+type Output struct {
+	Type   string  `json:"type" yaml:"type"`
+	Level  string  `json:"level,omitempty" yaml:"level,omitempty"`
+	Format *Format `json:"format,omitempty" yaml:"format,omitempty"`
+
+	// file and sqlite options
+	Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+	MaxSize int    `json:"max_size,omitempty" yaml:"max_size,omitempty"`
+
+	// database options
+	Address    string `json:"address,omitempty" yaml:"address,omitempty"`
+	Port       string `json:"port,omitempty" yaml:"port,omitempty"`
+	Database   string `json:"database,omitempty" yaml:"database,omitempty"`
+	Collection string `json:"collection,omitempty" yaml:"collection,omitempty"`
+
+	// gRPC options
+	Addresses []string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	Unary     bool     `json:"unary,omitempty" yaml:"unary,omitempty"`
+	Insecure  bool     `json:"insecure,omitempty" yaml:"insecure,omitempty"`
+	TLS       *TLS     `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Timing    bool     `json:"timing,omitempty" yaml:"timing,omitempty"`
+}
+
+// ParseJSON function decodes a JSON configuration document into a Config. Unknown keys are
+// rejected
+func ParseJSON(data []byte) (*Config, error) {
+	cfg := new(Config)
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// ParseYAML function decodes a YAML configuration document into a Config. Unknown keys are
+// rejected
+func ParseYAML(data []byte) (*Config, error) {
+	cfg := new(Config)
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to decode YAML configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// Load function reads the configuration document in the input path (a .json, .yaml or .yml
+// file) and applies the `ZLOG_*` environment variables to it
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg *Config
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		cfg, err = ParseJSON(data)
+	case ".yaml", ".yml":
+		cfg, err = ParseYAML(data)
+	default:
+		return nil, fmt.Errorf("%s: %w", path, ErrUnknownDocument)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if err := cfg.ApplyEnv(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,183 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const (
+	testJSON string = `{
+  "loggers": [
+    {
+      "prefix": "api",
+      "level": "info",
+      "fields": {"region": "eu"},
+      "format": {"type": "text", "color": true, "level_first": true},
+      "outputs": [
+        {"type": "stderr"},
+        {"type": "file", "path": "/tmp/api.log", "max_size": 10, "format": "json"}
+      ]
+    }
+  ]
+}`
+
+	testYAML string = `
+loggers:
+  - prefix: api
+    level: info
+    fields:
+      region: eu
+    format:
+      type: text
+      color: true
+      level_first: true
+    outputs:
+      - type: stderr
+      - type: file
+        path: /tmp/api.log
+        max_size: 10
+        format: json
+`
+)
+
+var testConfig = &Config{
+	Loggers: []Logger{{
+		Prefix: "api",
+		Level:  "info",
+		Fields: map[string]interface{}{"region": "eu"},
+		Format: &Format{Type: "text", Color: true, LevelFirst: true},
+		Outputs: []Output{
+			{Type: "stderr"},
+			{Type: "file", Path: "/tmp/api.log", MaxSize: 10, Format: &Format{Type: "json"}},
+		},
+	}},
+}
+
+func TestParse(t *testing.T) {
+	module := "Config"
+	funcname := "Parse()"
+
+	type test struct {
+		name  string
+		parse func([]byte) (*Config, error)
+		data  string
+		wants *Config
+		ok    bool
+	}
+
+	var tests = []test{
+		{
+			name:  "JSON document",
+			parse: ParseJSON,
+			data:  testJSON,
+			wants: testConfig,
+			ok:    true,
+		},
+		{
+			name:  "YAML document",
+			parse: ParseYAML,
+			data:  testYAML,
+			wants: testConfig,
+			ok:    true,
+		},
+		{
+			name:  "empty YAML document",
+			parse: ParseYAML,
+			data:  "",
+			wants: &Config{},
+			ok:    true,
+		},
+		{
+			name:  "unknown JSON key",
+			parse: ParseJSON,
+			data:  `{"loggers": [{"levle": "info"}]}`,
+		},
+		{
+			name:  "unknown YAML key",
+			parse: ParseYAML,
+			data:  "loggers:\n  - levle: info\n",
+		},
+		{
+			name:  "invalid YAML format object",
+			parse: ParseYAML,
+			data:  "loggers:\n  - format:\n      type: text\n      colour: true\n",
+		},
+	}
+
+	for idx, test := range tests {
+		cfg, err := test.parse([]byte(test.data))
+
+		if (err == nil) != test.ok {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+			continue
+		}
+
+		if test.ok && !reflect.DeepEqual(cfg, test.wants) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: wanted %+v ; got %+v -- action: %s", idx, module, funcname, test.wants, cfg, test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	module := "Config"
+	funcname := "Load()"
+
+	dir := t.TempDir()
+
+	type test struct {
+		name  string
+		file  string
+		data  string
+		wants *Config
+		err   error
+	}
+
+	var tests = []test{
+		{
+			name:  "load a JSON file",
+			file:  "zlog.json",
+			data:  testJSON,
+			wants: testConfig,
+		},
+		{
+			name:  "load a YAML file",
+			file:  "zlog.yml",
+			data:  testYAML,
+			wants: testConfig,
+		},
+		{
+			name: "unknown file extension",
+			file: "zlog.toml",
+			data: testJSON,
+			err:  ErrUnknownDocument,
+		},
+	}
+
+	for idx, test := range tests {
+		path := filepath.Join(dir, test.file)
+
+		if err := os.WriteFile(path, []byte(test.data), 0o600); err != nil {
+			t.Fatalf("#%v -- FAILED -- [%s] [%s] failed to write file: %v -- action: %s", idx, module, funcname, err, test.name)
+		}
+
+		cfg, err := Load(path)
+
+		if !errors.Is(err, test.err) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.err, err, test.name)
+			continue
+		}
+
+		if test.err == nil && !reflect.DeepEqual(cfg, test.wants) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: wanted %+v ; got %+v -- action: %s", idx, module, funcname, test.wants, cfg, test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
@@ -0,0 +1,203 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	envPrefix  string = "ZLOG_"
+	envLoggers string = "ZLOG_LOGGERS_"
+
+	// EnvConfig is the environment variable holding the path to the configuration document
+	// loaded by New(), when none is provided
+	EnvConfig string = "ZLOG_CONFIG"
+)
+
+// envKeys lists the supported environment variables (without the `ZLOG_` prefix), along with
+// the function applying each of them to a Logger
+var envKeys = map[string]func(l *Logger, value string) error{
+	"LEVEL": func(l *Logger, value string) error {
+		l.Level = value
+		return nil
+	},
+	"PREFIX": func(l *Logger, value string) error {
+		l.Prefix = value
+		return nil
+	},
+	"SUB": func(l *Logger, value string) error {
+		l.Sub = value
+		return nil
+	},
+	"FORMAT": func(l *Logger, value string) error {
+		l.Format = &Format{Type: value}
+		return nil
+	},
+	"SKIP_EXIT": func(l *Logger, value string) error {
+		skip, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%w: %q is not a boolean", ErrInvalidValue, value)
+		}
+
+		l.SkipExit = skip
+		return nil
+	},
+	"FIELDS": func(l *Logger, value string) error {
+		fields, err := parseFields(value)
+		if err != nil {
+			return err
+		}
+
+		if l.Fields == nil {
+			l.Fields = map[string]interface{}{}
+		}
+
+		for k, v := range fields {
+			l.Fields[k] = v
+		}
+		return nil
+	},
+	"OUTPUT": func(l *Logger, value string) error {
+		outputs, err := parseOutputs(value)
+		if err != nil {
+			return err
+		}
+
+		l.Outputs = outputs
+		return nil
+	},
+}
+
+// ApplyEnv method applies the `ZLOG_*` environment variables to the Config, replacing the values
+// in its document. Variables in the form of `ZLOG_<KEY>` apply to all Loggers, while those in the
+// form of `ZLOG_LOGGERS_<N>_<KEY>` apply to the Logger with index N (and take precedence). The
+// supported keys are:
+//
+//   - LEVEL: the level filter (like `warn`)
+//   - PREFIX, SUB: the prefix and sub-prefix
+//   - FORMAT: the formatter's name (like `json`)
+//   - SKIP_EXIT: a boolean
+//   - FIELDS: comma-separated key=value pairs, added to the Logger's fields
+//   - OUTPUT: comma-separated outputs, replacing the Logger's. Each is either `stderr`, `stdout`
+//     or `file:<path>`
+//
+// A Config without Loggers gets a default one, which the variables apply to. The returned
+// error is a *KeyError naming the offending variable
+func (c *Config) ApplyEnv() error {
+	if len(c.Loggers) == 0 {
+		c.Loggers = []Logger{{}}
+	}
+
+	// global keys, in a fixed order
+	keys := make([]string, 0, len(envKeys))
+	for k := range envKeys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value, ok := os.LookupEnv(envPrefix + k)
+		if !ok {
+			continue
+		}
+
+		for idx := range c.Loggers {
+			if err := envKeys[k](&c.Loggers[idx], value); err != nil {
+				return &KeyError{Key: envPrefix + k, Err: err}
+			}
+		}
+	}
+
+	// indexed keys
+	env := os.Environ()
+	sort.Strings(env)
+
+	for _, kv := range env {
+		name, value, _ := strings.Cut(kv, "=")
+
+		if !strings.HasPrefix(name, envLoggers) {
+			continue
+		}
+
+		idx, key, err := parseIndexedKey(strings.TrimPrefix(name, envLoggers))
+		if err != nil {
+			return &KeyError{Key: name, Err: err}
+		}
+
+		if idx >= len(c.Loggers) {
+			return &KeyError{Key: name, Err: fmt.Errorf("%w: the configuration has %v loggers", ErrNoLogger, len(c.Loggers))}
+		}
+
+		if err := envKeys[key](&c.Loggers[idx], value); err != nil {
+			return &KeyError{Key: name, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// parseIndexedKey function reads the `<N>_<KEY>` part of an indexed variable's name
+func parseIndexedKey(s string) (int, string, error) {
+	n, key, ok := strings.Cut(s, "_")
+	if !ok {
+		return 0, "", fmt.Errorf("%w: expected ZLOG_LOGGERS_<N>_<KEY>", ErrInvalidValue)
+	}
+
+	idx, err := strconv.Atoi(n)
+	if err != nil || idx < 0 {
+		return 0, "", fmt.Errorf("%w: %q is not a logger index", ErrInvalidValue, n)
+	}
+
+	if _, ok := envKeys[key]; !ok {
+		return 0, "", fmt.Errorf("%w: unknown key %q", ErrInvalidValue, key)
+	}
+
+	return idx, key, nil
+}
+
+// parseFields function reads comma-separated key=value pairs
+func parseFields(s string) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		k, v, ok := strings.Cut(pair, "=")
+		k = strings.TrimSpace(k)
+
+		if !ok || k == "" {
+			return nil, fmt.Errorf("%w: %q is not a key=value pair", ErrInvalidValue, pair)
+		}
+
+		fields[k] = strings.TrimSpace(v)
+	}
+
+	return fields, nil
+}
+
+// parseOutputs function reads comma-separated outputs, as `stderr`, `stdout` or `file:<path>`
+func parseOutputs(s string) ([]Output, error) {
+	var outputs []Output
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+
+		switch {
+		case item == "":
+			continue
+		case item == "stderr", item == "stdout":
+			outputs = append(outputs, Output{Type: item})
+		case strings.HasPrefix(item, "file:") && len(item) > len("file:"):
+			outputs = append(outputs, Output{Type: "file", Path: strings.TrimPrefix(item, "file:")})
+		default:
+			return nil, fmt.Errorf("%w: %q -- use stderr, stdout or file:<path>", ErrUnknownOutput, item)
+		}
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,124 @@
+package config
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestApplyEnv(t *testing.T) {
+	module := "Config"
+	funcname := "ApplyEnv()"
+
+	type test struct {
+		name  string
+		env   map[string]string
+		cfg   *Config
+		wants *Config
+		key   string
+		err   error
+	}
+
+	var tests = []test{
+		{
+			name:  "no variables",
+			cfg:   &Config{Loggers: []Logger{{Prefix: "api"}}},
+			wants: &Config{Loggers: []Logger{{Prefix: "api"}}},
+		},
+		{
+			name:  "empty config gets a default logger",
+			env:   map[string]string{"ZLOG_LEVEL": "warn"},
+			cfg:   &Config{},
+			wants: &Config{Loggers: []Logger{{Level: "warn"}}},
+		},
+		{
+			name: "global variables apply to all loggers",
+			env: map[string]string{
+				"ZLOG_PREFIX":    "svc",
+				"ZLOG_FORMAT":    "json",
+				"ZLOG_SKIP_EXIT": "true",
+				"ZLOG_FIELDS":    "env=prod, region=eu",
+			},
+			cfg: &Config{Loggers: []Logger{{Prefix: "api", Fields: map[string]interface{}{"a": 1}}, {}}},
+			wants: &Config{Loggers: []Logger{
+				{Prefix: "svc", SkipExit: true, Format: &Format{Type: "json"}, Fields: map[string]interface{}{"a": 1, "env": "prod", "region": "eu"}},
+				{Prefix: "svc", SkipExit: true, Format: &Format{Type: "json"}, Fields: map[string]interface{}{"env": "prod", "region": "eu"}},
+			}},
+		},
+		{
+			name: "indexed variables take precedence",
+			env: map[string]string{
+				"ZLOG_LEVEL":            "warn",
+				"ZLOG_LOGGERS_1_LEVEL":  "debug",
+				"ZLOG_LOGGERS_1_OUTPUT": "stdout,file:/tmp/app.log",
+			},
+			cfg: &Config{Loggers: []Logger{{}, {Outputs: []Output{{Type: "stderr"}}}}},
+			wants: &Config{Loggers: []Logger{
+				{Level: "warn"},
+				{Level: "debug", Outputs: []Output{{Type: "stdout"}, {Type: "file", Path: "/tmp/app.log"}}},
+			}},
+		},
+		{
+			name: "invalid boolean",
+			env:  map[string]string{"ZLOG_SKIP_EXIT": "maybe"},
+			cfg:  &Config{},
+			key:  "ZLOG_SKIP_EXIT",
+			err:  ErrInvalidValue,
+		},
+		{
+			name: "invalid output",
+			env:  map[string]string{"ZLOG_OUTPUT": "stderr,syslog"},
+			cfg:  &Config{},
+			key:  "ZLOG_OUTPUT",
+			err:  ErrUnknownOutput,
+		},
+		{
+			name: "logger index out of range",
+			env:  map[string]string{"ZLOG_LOGGERS_2_LEVEL": "info"},
+			cfg:  &Config{Loggers: []Logger{{}}},
+			key:  "ZLOG_LOGGERS_2_LEVEL",
+			err:  ErrNoLogger,
+		},
+		{
+			name: "unknown indexed key",
+			env:  map[string]string{"ZLOG_LOGGERS_0_COLOUR": "true"},
+			cfg:  &Config{},
+			key:  "ZLOG_LOGGERS_0_COLOUR",
+			err:  ErrInvalidValue,
+		},
+	}
+
+	for idx, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+
+			err := test.cfg.ApplyEnv()
+
+			if test.err != nil {
+				var kerr *KeyError
+
+				if !errors.Is(err, test.err) || !errors.As(err, &kerr) || kerr.Key != test.key {
+					t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v in %s ; got %v -- action: %s", idx, module, funcname, test.err, test.key, err, test.name)
+					return
+				}
+
+				t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+				return
+			}
+
+			if err != nil {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+				return
+			}
+
+			if !reflect.DeepEqual(test.cfg, test.wants) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: wanted %+v ; got %+v -- action: %s", idx, module, funcname, test.wants, test.cfg, test.name)
+				return
+			}
+
+			t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+		})
+	}
+}
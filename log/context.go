@@ -0,0 +1,53 @@
+package log
+
+import (
+	"context"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+type loggerCtxKey struct{}
+
+// NewContext function will return a copy of the input context.Context carrying the input Logger,
+// so that it can be retrieved further down the call chain with FromContext()
+//
+// Request-scoped metadata should be added to the context with event.NewContext(), and merged
+// into events with the event.EventBuilder's Ctx() method:
+//
+//	ctx = log.NewContext(ctx, logger)
+//	ctx = event.NewContext(ctx, map[string]interface{}{"req_id": reqID})
+//
+//	log.FromContext(ctx).Log(
+//	    event.New().Level(event.Level_info).Message("handling request").Ctx(ctx).Build(),
+//	)
+func NewContext(ctx context.Context, l Logger) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if l == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, loggerCtxKey{}, l)
+}
+
+// FromContext function will return the Logger stored in the input context.Context. If the
+// context does not carry a Logger, the default (package-level) Logger is returned instead
+func FromContext(ctx context.Context) Logger {
+	if ctx == nil {
+		return std
+	}
+
+	if l, ok := ctx.Value(loggerCtxKey{}).(Logger); ok && l != nil {
+		return l
+	}
+
+	return std
+}
+
+// FieldsFrom function will return the metadata fields carried by the input context.Context, which
+// are set with event.NewContext()
+func FieldsFrom(ctx context.Context) map[string]interface{} {
+	return event.FromContext(ctx).AsMap()
+}
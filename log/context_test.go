@@ -0,0 +1,88 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestContext(t *testing.T) {
+	module := "Logger"
+	funcname := "NewContext()"
+
+	buf := new(bytes.Buffer)
+	logger := New(WithOut(buf), SkipExit, CfgFormatJSON)
+
+	type test struct {
+		name  string
+		ctx   context.Context
+		wants Logger
+	}
+
+	var tests = []test{
+		{
+			name:  "context with a logger",
+			ctx:   NewContext(context.Background(), logger),
+			wants: logger,
+		},
+		{
+			name:  "context without a logger",
+			ctx:   context.Background(),
+			wants: std,
+		},
+		{
+			name:  "nil context",
+			ctx:   nil,
+			wants: std,
+		},
+		{
+			name:  "nil logger",
+			ctx:   NewContext(context.Background(), nil),
+			wants: std,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		if l := FromContext(test.ctx); l != test.wants {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				l,
+				test.name,
+			)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// context-carried fields are merged into the event metadata
+	ctx := NewContext(context.Background(), logger)
+	ctx = event.NewContext(ctx, map[string]interface{}{"req_id": "abc"})
+
+	FromContext(ctx).Log(event.New().Level(event.Level_info).Message("with context").Ctx(ctx).Build())
+
+	if !strings.Contains(buf.String(), `"req_id":"abc"`) {
+		t.Errorf("[%s] [%s] context fields missing from the output: %s", module, funcname, buf.String())
+	}
+
+	if f := FieldsFrom(ctx); f["req_id"] != "abc" {
+		t.Errorf("[%s] [FieldsFrom()] unexpected output: %v", module, f)
+	}
+}
@@ -0,0 +1,279 @@
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// Deduper struct describes a module that suppresses repeated events within a time window.
+//
+// Events are considered repeated when they share the same level, prefix, sub-prefix and message
+// (and, optionally, the same values for a set of metadata keys). The first event of a kind is
+// written, while its repeats within the window are suppressed and counted. Once the window
+// elapses, a summary event is written with the number of repeats and the timestamps of the first
+// and last occurrences (this summary event skips deduplication).
+//
+// A Deduper should be used by a single Logger (or gRPC Log Server).
+type Deduper struct {
+	mu      sync.Mutex
+	window  time.Duration
+	keys    []string
+	entries map[string]*dedupEntry
+	pending []*dedupEntry
+	total   uint64
+	timer   *time.Timer
+	report  func(m *event.Event)
+}
+
+type dedupEntry struct {
+	level  event.Level
+	prefix string
+	sub    string
+	msg    string
+	meta   map[string]interface{}
+	first  time.Time
+	last   time.Time
+	count  uint64
+}
+
+// LCDedup struct is a custom LoggerConfig to define a Deduper for new Loggers
+type LCDedup struct {
+	d *Deduper
+}
+
+// Apply method will set the configured Deduper to the input pointer to a LoggerBuilder
+func (c *LCDedup) Apply(lb *LoggerBuilder) {
+	lb.Deduper = c.d
+}
+
+// WithDedup function will allow creating a LoggerConfig that suppresses repeated events within the
+// input time window, writing a summary event for each kind of repeated event once it elapses.
+//
+// Events are keyed on their level, prefix, sub-prefix and message, as well as on the values of the
+// input metadata keys (if any):
+//
+//	logger := log.New(
+//	    log.WithDedup(10*time.Second, "code"), // [error] [db] "query failed" {"code": 500} is written once per 10s
+//	)
+//
+// Deduplication is applied after the Logger's hooks, and before its Sampler (if set).
+func WithDedup(window time.Duration, keys ...string) LoggerConfig {
+	if window <= 0 {
+		return nil
+	}
+
+	return &LCDedup{
+		d: NewDeduper(window, keys...),
+	}
+}
+
+// NewDeduper function will create a Deduper that suppresses repeated events within the input
+// time window, keyed on their level, prefix, sub-prefix, message and the values of the input
+// metadata keys.
+//
+// A zero or negative window defaults to one second.
+func NewDeduper(window time.Duration, keys ...string) *Deduper {
+	if window <= 0 {
+		window = time.Second
+	}
+
+	return &Deduper{
+		window:  window,
+		keys:    keys,
+		entries: map[string]*dedupEntry{},
+	}
+}
+
+// Bind method sets the function used to write the Deduper's summary events, and returns the
+// Deduper. Loggers configured with WithDedup() bind their own Deduper.
+func (d *Deduper) Bind(fn func(m *event.Event)) *Deduper {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.report = fn
+	return d
+}
+
+// Suppressed method returns the total number of events suppressed by this Deduper
+func (d *Deduper) Suppressed() uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.total
+}
+
+// key method builds the input event.Event's deduplication key
+func (d *Deduper) key(m *event.Event) (string, map[string]interface{}) {
+	var sb strings.Builder
+	var meta map[string]interface{}
+
+	sb.WriteString(m.GetLevel().String())
+	sb.WriteByte(0)
+	sb.WriteString(m.GetPrefix())
+	sb.WriteByte(0)
+	sb.WriteString(m.GetSub())
+	sb.WriteByte(0)
+	sb.WriteString(m.GetMsg())
+
+	if len(d.keys) > 0 {
+		all := m.Metadata()
+		meta = make(map[string]interface{}, len(d.keys))
+
+		for _, k := range d.keys {
+			v, ok := all[k]
+
+			sb.WriteByte(0)
+			if ok {
+				meta[k] = v
+				sb.WriteString(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+
+	return sb.String(), meta
+}
+
+// Dedup method will return a boolean on whether the input event.Event should be written, or
+// if it is a repeat within the window and should be suppressed
+func (d *Deduper) Dedup(m *event.Event) bool {
+	k, meta := d.key(m)
+	now := time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	e, ok := d.entries[k]
+
+	if ok && now.Sub(e.first) < d.window {
+		e.count++
+		e.last = now
+		d.total++
+		d.arm()
+		return false
+	}
+
+	// window elapsed; keep its repeats to be reported
+	if ok && e.count > 0 {
+		d.pending = append(d.pending, e)
+	}
+
+	d.entries[k] = &dedupEntry{
+		level:  m.GetLevel(),
+		prefix: m.GetPrefix(),
+		sub:    m.GetSub(),
+		msg:    m.GetMsg(),
+		meta:   meta,
+		first:  now,
+		last:   now,
+	}
+
+	d.arm()
+	return true
+}
+
+// arm method starts the timer that writes the summary events, if not running. It must be called
+// while holding the lock
+func (d *Deduper) arm() {
+	if d.timer == nil {
+		d.timer = time.AfterFunc(d.window, d.tick)
+	}
+}
+
+// tick method writes the summaries for the windows that have elapsed, and clears their entries
+func (d *Deduper) tick() {
+	d.mu.Lock()
+	d.timer = nil
+
+	now := time.Now()
+	out := d.pending
+	d.pending = nil
+
+	// the next tick is set for the earliest window to elapse
+	var next time.Duration
+
+	for k, e := range d.entries {
+		if left := d.window - now.Sub(e.first); left > 0 {
+			if next == 0 || left < next {
+				next = left
+			}
+			continue
+		}
+
+		if e.count > 0 {
+			out = append(out, e)
+		}
+
+		delete(d.entries, k)
+	}
+
+	if next > 0 {
+		d.timer = time.AfterFunc(next, d.tick)
+	}
+
+	report := d.report
+	d.mu.Unlock()
+
+	d.write(report, out)
+}
+
+// Flush method will write the summaries for all repeated events immediately, regardless of their
+// window having elapsed, and clear the Deduper's entries
+func (d *Deduper) Flush() {
+	d.mu.Lock()
+
+	out := d.pending
+	d.pending = nil
+
+	for k, e := range d.entries {
+		if e.count > 0 {
+			out = append(out, e)
+		}
+		delete(d.entries, k)
+	}
+
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+
+	report := d.report
+	d.mu.Unlock()
+
+	d.write(report, out)
+}
+
+func (d *Deduper) write(report func(m *event.Event), entries []*dedupEntry) {
+	if report == nil {
+		return
+	}
+
+	for _, e := range entries {
+		report(d.summary(e))
+	}
+}
+
+// summary method builds the summary event for the input entry
+func (d *Deduper) summary(e *dedupEntry) *event.Event {
+	meta := make(map[string]interface{}, len(e.meta)+4)
+
+	for k, v := range e.meta {
+		meta[k] = v
+	}
+
+	meta["repeated"] = e.count
+	meta["first"] = e.first
+	meta["last"] = e.last
+	meta["window"] = d.window.String()
+
+	return event.New().
+		Level(e.level).
+		Prefix(e.prefix).
+		Sub(e.sub).
+		Message(fmt.Sprintf("%s -- message repeated %d times in %s", e.msg, e.count, d.window)).
+		Metadata(meta).
+		Build()
+}
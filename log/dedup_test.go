@@ -0,0 +1,190 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestWithDedup(t *testing.T) {
+	module := "Deduper"
+	funcname := "WithDedup()"
+
+	if WithDedup(0) != nil {
+		t.Errorf("[%s] [%s] expected a nil LoggerConfig with a zero window", module, funcname)
+	}
+
+	if d := NewDeduper(-1); d.window != time.Second {
+		t.Errorf("[%s] [NewDeduper()] expected a default window of one second; got %v", module, d.window)
+	}
+
+	lb := &LoggerBuilder{}
+	WithDedup(time.Minute, "code").Apply(lb)
+
+	if lb.Deduper == nil || lb.Deduper.window != time.Minute || len(lb.Deduper.keys) != 1 {
+		t.Errorf("[%s] [%s] unexpected Deduper: %v", module, funcname, lb.Deduper)
+	}
+}
+
+func TestDedup(t *testing.T) {
+	module := "Deduper"
+	funcname := "Dedup()"
+
+	type test struct {
+		name   string
+		keys   []string
+		events []*event.Event
+		wants  int
+	}
+
+	var tests = []test{
+		{
+			name: "repeated events",
+			events: []*event.Event{
+				event.New().Message("null").Build(),
+				event.New().Message("null").Build(),
+				event.New().Message("null").Build(),
+			},
+			wants: 1,
+		},
+		{
+			name: "different levels, prefixes and messages",
+			events: []*event.Event{
+				event.New().Message("null").Build(),
+				event.New().Level(event.Level_warn).Message("null").Build(),
+				event.New().Prefix("test").Message("null").Build(),
+				event.New().Sub("test").Message("null").Build(),
+				event.New().Message("nil").Build(),
+			},
+			wants: 5,
+		},
+		{
+			name: "metadata is ignored without keys",
+			events: []*event.Event{
+				event.New().Message("null").Metadata(map[string]interface{}{"code": 1}).Build(),
+				event.New().Message("null").Metadata(map[string]interface{}{"code": 2}).Build(),
+			},
+			wants: 1,
+		},
+		{
+			name: "metadata keys",
+			keys: []string{"code"},
+			events: []*event.Event{
+				event.New().Message("null").Metadata(map[string]interface{}{"code": 1, "a": 1}).Build(),
+				event.New().Message("null").Metadata(map[string]interface{}{"code": 1, "a": 2}).Build(),
+				event.New().Message("null").Metadata(map[string]interface{}{"code": 2}).Build(),
+				event.New().Message("null").Build(),
+			},
+			wants: 3,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		d := NewDeduper(time.Minute, test.keys...)
+
+		var n int
+
+		for _, e := range test.events {
+			if d.Dedup(e) {
+				n++
+			}
+		}
+
+		if n != test.wants {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v written events; got %v -- action: %s", idx, module, funcname, test.wants, n, test.name)
+			return
+		}
+
+		if d.Suppressed() != uint64(len(test.events)-n) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v suppressed events; got %v -- action: %s", idx, module, funcname, len(test.events)-n, d.Suppressed(), test.name)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestDedupSummary(t *testing.T) {
+	module := "Deduper"
+	funcname := "tick()"
+
+	buf := new(bytes.Buffer)
+	logger := New(WithOut(buf), SkipExit, CfgFormatJSON, WithDedup(50*time.Millisecond))
+
+	for i := 0; i < 10; i++ {
+		logger.Error("repeated")
+	}
+
+	// window elapses and a summary is written
+	time.Sleep(100 * time.Millisecond)
+	logger.Error("repeated")
+
+	out := buf.String()
+
+	if n := strings.Count(out, `"message":"repeated"`); n != 2 {
+		t.Errorf("[%s] [%s] expected 2 written events, got %v: %s", module, funcname, n, out)
+	}
+
+	if !strings.Contains(out, "repeated -- message repeated 9 times in 50ms") {
+		t.Errorf("[%s] [%s] summary event not found in output: %s", module, funcname, out)
+	}
+
+	if !strings.Contains(out, `"first":`) || !strings.Contains(out, `"last":`) {
+		t.Errorf("[%s] [%s] expected first and last timestamps in output: %s", module, funcname, out)
+	}
+}
+
+func TestDedupFlush(t *testing.T) {
+	module := "Deduper"
+	funcname := "Flush()"
+
+	var summaries []*event.Event
+
+	d := NewDeduper(time.Hour, "code").Bind(func(m *event.Event) {
+		summaries = append(summaries, m)
+	})
+
+	for i := 0; i < 5; i++ {
+		d.Dedup(event.New().Level(event.Level_error).Message("null").Metadata(map[string]interface{}{"code": 500}).Build())
+	}
+	d.Dedup(event.New().Message("once").Build())
+
+	d.Flush()
+
+	if len(summaries) != 1 {
+		t.Errorf("[%s] [%s] expected one summary event; got %v", module, funcname, len(summaries))
+		return
+	}
+
+	s := summaries[0]
+	meta := s.Metadata()
+
+	if s.GetLevel() != event.Level_error || meta["repeated"] != uint64(4) || meta["code"] != int64(500) {
+		t.Errorf("[%s] [%s] unexpected summary event: %v", module, funcname, s)
+	}
+
+	first, _ := meta["first"].(time.Time)
+	last, _ := meta["last"].(time.Time)
+
+	if first.IsZero() || last.Before(first) {
+		t.Errorf("[%s] [%s] unexpected first / last timestamps: %v / %v", module, funcname, first, last)
+	}
+
+	// entries are cleared
+	if !d.Dedup(event.New().Level(event.Level_error).Message("null").Metadata(map[string]interface{}{"code": 500}).Build()) {
+		t.Errorf("[%s] [%s] expected the event to be written after flushing", module, funcname)
+	}
+}
@@ -0,0 +1,140 @@
+package log
+
+import (
+	"io"
+	"sync"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// LCErrorHandler struct is a custom LoggerConfig to set a function called with the errors raised
+// when writing events
+type LCErrorHandler struct {
+	fn func(err error, ev *event.Event)
+}
+
+// LCFallback struct is a custom LoggerConfig to set an io.Writer where the events that fail to be
+// written are rerouted to
+type LCFallback struct {
+	out io.Writer
+	fmt LogFormatter
+}
+
+// Apply method will set the configured error handler to the input pointer to a LoggerBuilder
+func (c *LCErrorHandler) Apply(lb *LoggerBuilder) {
+	lb.ErrorHandler = c.fn
+}
+
+// Apply method will set the configured fallback io.Writer and LogFormatter to the input pointer to a
+// LoggerBuilder
+func (c *LCFallback) Apply(lb *LoggerBuilder) {
+	lb.Fallback = c.out
+	lb.FallbackFmt = c.fmt
+}
+
+// WithErrorHandler function will allow creating a LoggerConfig that sets a function to be called
+// whenever the Logger fails to write an event -- as the Print*() and Log() methods discard the error
+// returned by Output(). It returns nil if the input function is nil.
+//
+// The function receives the error and the event.Event that failed to be written, for events that fail
+// to be formatted, fail to be written to the Logger's io.Writer or to any of its Sinks (such as a
+// database writer), or that are written to a closed Logger:
+//
+//	var failed uint64
+//
+//	logger := log.New(
+//	    log.WithDatabase(db),
+//	    log.WithErrorHandler(func(err error, ev *event.Event) {
+//	        atomic.AddUint64(&failed, 1)
+//	    }),
+//	)
+//
+// The function is shared with the Logger's children, and it may be called concurrently (e.g. from
+// the Logger's asynchronous queue); but never while the Logger holds a lock, so it is safe to log
+// the error through a different Logger
+func WithErrorHandler(fn func(err error, ev *event.Event)) LoggerConfig {
+	if fn == nil {
+		return nil
+	}
+
+	return &LCErrorHandler{
+		fn: fn,
+	}
+}
+
+// WithFallback function will allow creating a LoggerConfig that reroutes the events that the Logger
+// fails to write to the input io.Writer, such as os.Stderr or a local spool file. It returns nil if
+// the input io.Writer is nil.
+//
+// Events are written with the input LogFormatter; or if nil, with the same bytes that failed to be
+// written (falling back to FormatText if the event could not be formatted). The fallback io.Writer
+// is written to after the error handler (if set) is called, and its own errors are ignored. It is
+// not closed with the Logger
+func WithFallback(out io.Writer, fmt LogFormatter) LoggerConfig {
+	if out == nil {
+		return nil
+	}
+
+	return &LCFallback{
+		out: out,
+		fmt: fmt,
+	}
+}
+
+// fallback struct holds a Logger's fallback io.Writer and LogFormatter, with its own lock so that
+// it is written to outside of the Logger's write lock
+type fallback struct {
+	mu  sync.Mutex
+	out io.Writer
+	fmt LogFormatter
+}
+
+// newFallback function returns nil if the input io.Writer is nil, or a fallback otherwise
+func newFallback(out io.Writer, fmt LogFormatter) *fallback {
+	if out == nil {
+		return nil
+	}
+
+	return &fallback{
+		out: out,
+		fmt: fmt,
+	}
+}
+
+// write method will write the input event.Event to the fallback io.Writer, reusing the input buffer
+// unless the fallback has its own LogFormatter (or the buffer is empty)
+func (f *fallback) write(m *event.Event, buf []byte) {
+	if f.fmt != nil || len(buf) == 0 {
+		lf := f.fmt
+
+		if lf == nil {
+			lf = FormatText
+		}
+
+		var err error
+
+		if buf, err = lf.Format(m); err != nil {
+			return
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, _ = f.out.Write(buf) // deliberately ignore error in this method call
+}
+
+// handleError method will pass the input error and event.Event to the root Logger's error handler,
+// and then reroute the event to its fallback io.Writer (if set). The input buffer is the event as it
+// was formatted by the Logger, if it was formatted at all
+func (l *logger) handleError(err error, m *event.Event, buf []byte) {
+	root := l.root()
+
+	if root.errFn != nil {
+		root.errFn(err, m)
+	}
+
+	if root.fallback != nil {
+		root.fallback.write(m, buf)
+	}
+}
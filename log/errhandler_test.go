@@ -0,0 +1,226 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/log/format/text"
+)
+
+type testErrors struct {
+	mu   sync.Mutex
+	errs []error
+	msgs []string
+}
+
+func (e *testErrors) handle(err error, ev *event.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.errs = append(e.errs, err)
+	e.msgs = append(e.msgs, ev.GetMsg())
+}
+
+func TestWithErrorHandler(t *testing.T) {
+	module := "LoggerConfig"
+	funcname := "WithErrorHandler()"
+
+	type test struct {
+		name  string
+		confs []LoggerConfig
+		log   func(l Logger)
+		errs  int
+	}
+
+	if WithErrorHandler(nil) != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a nil config -- action: %s", 0, module, funcname, "nil function")
+		return
+	}
+
+	var tests = []test{
+		{
+			name: "no errors",
+			log:  func(l Logger) { l.Info("null") },
+			errs: 0,
+		},
+		{
+			name:  "write error",
+			confs: []LoggerConfig{WithOut(testFailingWriter{})},
+			log:   func(l Logger) { l.Info("null") },
+			errs:  1,
+		},
+		{
+			name:  "format error",
+			confs: []LoggerConfig{testFailingFormatter{}},
+			log:   func(l Logger) { l.Log(event.New().Message("null").Build()) },
+			errs:  1,
+		},
+		{
+			name:  "sink write error",
+			confs: []LoggerConfig{WithSink(testFailingWriter{}, nil, event.Level_warn)},
+			log: func(l Logger) {
+				l.Info("skipped")
+				l.Warn("null")
+			},
+			errs: 1,
+		},
+		{
+			name:  "write error in a child logger",
+			confs: []LoggerConfig{WithOut(testFailingWriter{})},
+			log:   func(l Logger) { l.With(map[string]interface{}{"a": 1}).Warnf("%s", "null") },
+			errs:  1,
+		},
+		{
+			name:  "write error in an asynchronous logger",
+			confs: []LoggerConfig{WithOut(testFailingWriter{}), WithAsync(16, OverflowBlock)},
+			log: func(l Logger) {
+				l.Info("null")
+				_ = l.Sync()
+			},
+			errs: 1,
+		},
+		{
+			name: "write to a closed logger",
+			log: func(l Logger) {
+				_ = l.Close()
+				l.Error("null")
+			},
+			errs: 1,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		handler := new(testErrors)
+
+		confs := append([]LoggerConfig{
+			WithOut(new(bytes.Buffer)),
+			CfgTextOnly,
+			WithErrorHandler(handler.handle),
+		}, test.confs...)
+
+		test.log(New(confs...))
+
+		handler.mu.Lock()
+		defer handler.mu.Unlock()
+
+		if len(handler.errs) != test.errs {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v errors; got %v: %v -- action: %s", idx, module, funcname, test.errs, len(handler.errs), handler.errs, test.name)
+			return
+		}
+
+		for i, msg := range handler.msgs {
+			if handler.errs[i] == nil || msg != "null" {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error or event: %v, %q -- action: %s", idx, module, funcname, handler.errs[i], msg, test.name)
+				return
+			}
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestWithFallback(t *testing.T) {
+	module := "LoggerConfig"
+	funcname := "WithFallback()"
+
+	type test struct {
+		name  string
+		confs []LoggerConfig
+		fmt   LogFormatter
+		wants string
+	}
+
+	if WithFallback(nil, nil) != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a nil config -- action: %s", 0, module, funcname, "nil writer")
+		return
+	}
+
+	var tests = []test{
+		{
+			name:  "reroute the formatted event",
+			confs: []LoggerConfig{WithOut(testFailingWriter{}), WithFormat(text.New().NoHeaders().NoTimestamp().NoLevel().Build())},
+			wants: "null\n",
+		},
+		{
+			name:  "reroute the event with the fallback's formatter",
+			confs: []LoggerConfig{WithOut(testFailingWriter{}), CfgTextOnly},
+			fmt:   FormatJSON,
+			wants: `"message":"null"`,
+		},
+		{
+			name:  "reroute an event that failed to be formatted",
+			confs: []LoggerConfig{WithOut(new(bytes.Buffer)), testFailingFormatter{}},
+			wants: "null",
+		},
+		{
+			name:  "no errors",
+			confs: []LoggerConfig{WithOut(new(bytes.Buffer)), CfgTextOnly},
+			wants: "",
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		fb := new(bytes.Buffer)
+
+		logger := New(append(test.confs, WithFallback(fb, test.fmt))...)
+		logger.Info("null")
+
+		if test.wants == "" && fb.Len() > 0 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected fallback write: %q -- action: %s", idx, module, funcname, fb.String(), test.name)
+			return
+		}
+
+		if !strings.Contains(fb.String(), test.wants) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %q ; got %q -- action: %s", idx, module, funcname, test.wants, fb.String(), test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestMultiLoggerErrorHandler(t *testing.T) {
+	module := "MultiLogger"
+	funcname := "Output()"
+
+	var (
+		handlers = []*testErrors{{}, {}}
+		buf      = new(bytes.Buffer)
+		fb       = new(bytes.Buffer)
+	)
+
+	logger := MultiLogger(
+		New(WithOut(testFailingWriter{}), CfgTextOnly, WithErrorHandler(handlers[0].handle), WithFallback(fb, nil)),
+		New(WithOut(buf), CfgTextOnly, WithErrorHandler(handlers[1].handle)),
+	)
+
+	_, err := logger.Output(event.New().Message("null").Build())
+
+	if err == nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected an error -- action: %s", 0, module, funcname, "failing logger")
+		return
+	}
+
+	if len(handlers[0].errs) != 1 || len(handlers[1].errs) != 0 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected errors: %v ; %v -- action: %s", 0, module, funcname, handlers[0].errs, handlers[1].errs, "failing logger")
+		return
+	}
+
+	if !strings.Contains(buf.String(), "null") || !strings.Contains(fb.String(), "null") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the event in the output and fallback: %q ; %q -- action: %s", 0, module, funcname, buf.String(), fb.String(), "failing logger")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "failing logger")
+}
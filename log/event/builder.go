@@ -18,6 +18,9 @@ type EventBuilder struct {
 	BLevel    *Level
 	BMsg      string
 	BMetadata *map[string]interface{}
+	BCaller   *Caller
+	BError    *Error
+	BAttrs    []Attr
 }
 
 // New function is the initializer of an EventBuilder. From this call, further
@@ -77,6 +80,8 @@ func (b *EventBuilder) Metadata(m map[string]interface{}) *EventBuilder {
 		return b
 	}
 
+	b.flushAttrs()
+
 	if b.BMetadata == nil || len(*b.BMetadata) == 0 {
 		b.BMetadata = &m
 	} else {
@@ -89,14 +94,73 @@ func (b *EventBuilder) Metadata(m map[string]interface{}) *EventBuilder {
 	return b
 }
 
+// Attrs method will add the input typed Attrs to the metadata element in the EventBuilder,
+// and return the builder. Attrs are set in order, replacing any existing keys:
+//
+//	event.New().Message("request served").Attrs(
+//		event.String("path", "/"),
+//		event.Int("status", 200),
+//		event.Dur("elapsed", time.Since(start)),
+//	).Build()
+//
+// As their values are already converted, Attrs are placed directly in the event's metadata
+// when it is built
+func (b *EventBuilder) Attrs(attrs ...Attr) *EventBuilder {
+	b.BAttrs = append(b.BAttrs, attrs...)
+	return b
+}
+
+// flushAttrs moves any pending Attrs into the metadata map, so that they keep their order
+// relative to a following Metadata() call
+func (b *EventBuilder) flushAttrs() {
+	if len(b.BAttrs) == 0 {
+		return
+	}
+
+	// copy any existing metadata, as it may be a map owned by the caller
+	var meta = make(map[string]interface{}, len(b.BAttrs))
+
+	if b.BMetadata != nil {
+		for k, v := range *b.BMetadata {
+			meta[k] = v
+		}
+	}
+
+	for _, a := range b.BAttrs {
+		meta[a.Key] = a
+	}
+
+	b.BMetadata = &meta
+	b.BAttrs = nil
+}
+
 // CallStack method will grab the current call stack, and add it as a "callstack" object
-// in the EventBuilder's metadata.
+// in the EventBuilder's metadata. If `all` is true, the call stacks of all goroutines are added.
+//
+// The call stack is captured with trace.DefaultTracer, which drops the frames of the Go runtime,
+// the standard library and this library; use CallStackFrom() to add a call stack captured with
+// a custom trace.Tracer
 func (b *EventBuilder) CallStack(all bool) *EventBuilder {
+	return b.setCallStack(trace.New(all))
+}
+
+// CallStackFrom method will add the input call stack (as captured by a trace.Tracer) as a
+// "callstack" object in the EventBuilder's metadata, in the same format as CallStack()
+func (b *EventBuilder) CallStackFrom(s trace.Stack) *EventBuilder {
+	return b.setCallStack(s.Map())
+}
+
+func (b *EventBuilder) setCallStack(stack map[string]interface{}) *EventBuilder {
+	if b.BMetadata == nil {
+		b.BMetadata = new(map[string]interface{})
+	}
+
 	if *b.BMetadata == nil {
 		*b.BMetadata = map[string]interface{}{}
 	}
+
 	mcopy := *b.BMetadata
-	mcopy["callstack"] = trace.New(all)
+	mcopy[trace.Key] = stack
 	*b.BMetadata = mcopy
 
 	return b
@@ -107,6 +171,7 @@ func (b *EventBuilder) CallStack(all bool) *EventBuilder {
 func (b *EventBuilder) Build() *Event {
 	var timestamp *timestamppb.Timestamp = timestamppb.Now()
 	var meta *structpb.Struct
+	var data map[string]*Value
 
 	if b.BLevel == nil {
 		b.BLevel = new(Level)
@@ -128,6 +193,30 @@ func (b *EventBuilder) Build() *Event {
 	} else {
 		f := Field(*b.BMetadata)
 		meta = f.Encode()
+		data = f.Data()
+	}
+
+	if len(b.BAttrs) > 0 {
+		if meta == nil {
+			meta = &structpb.Struct{}
+		}
+
+		if meta.Fields == nil {
+			meta.Fields = make(map[string]*structpb.Value, len(b.BAttrs))
+		}
+
+		for _, a := range b.BAttrs {
+			meta.Fields[a.Key] = a.Value
+
+			if a.Data != nil {
+				if data == nil {
+					data = map[string]*Value{}
+				}
+				data[a.Key] = a.Data
+			} else {
+				delete(data, a.Key)
+			}
+		}
 	}
 
 	return &Event{
@@ -137,5 +226,8 @@ func (b *EventBuilder) Build() *Event {
 		Level:  b.BLevel,
 		Msg:    &b.BMsg,
 		Meta:   meta,
+		Caller: b.BCaller,
+		Error:  b.BError,
+		Data:   data,
 	}
 }
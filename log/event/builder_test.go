@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/zalgonoise/zlog/log/trace"
 )
 
 func TestNew(t *testing.T) {
@@ -455,6 +457,28 @@ func TestCallStack(t *testing.T) {
 	}
 }
 
+func TestCallStackFrom(t *testing.T) {
+	module := "EventBuilder"
+	funcname := "CallStackFrom()"
+
+	stack := trace.NewTracer(1).Capture(0)
+	e := (&EventBuilder{}).CallStackFrom(stack).Build()
+
+	c, ok := e.GetMeta().AsMap()[trace.Key].(map[string]interface{})
+
+	if !ok {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] event metadata doesn't contain a callstack key -- action: %s", 0, module, funcname, "add captured stack")
+		return
+	}
+
+	if got, ok := trace.FromMap(c); !ok || !reflect.DeepEqual(got, stack) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s", 0, module, funcname, stack, got, "add captured stack")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "add captured stack")
+}
+
 func TestBuild(t *testing.T) {
 	module := "EventBuilder"
 	funcname := "Build()"
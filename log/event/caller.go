@@ -0,0 +1,61 @@
+package event
+
+import (
+	"path"
+	"runtime"
+	"strconv"
+)
+
+// NewCaller function will return a Caller describing the source location of the function
+// `skip` frames above the one calling NewCaller (a zero skip value returns the caller of
+// NewCaller itself). It returns nil if the location cannot be retrieved
+func NewCaller(skip int) *Caller {
+	pc, file, line, ok := runtime.Caller(skip + 1)
+
+	if !ok {
+		return nil
+	}
+
+	var fn string
+
+	if f := runtime.FuncForPC(pc); f != nil {
+		fn = f.Name()
+	}
+
+	return NewCallerFrom(file, line, fn)
+}
+
+// NewCallerFrom function will create a Caller from the input file, line and function name
+func NewCallerFrom(file string, line int, function string) *Caller {
+	l := int32(line)
+
+	return &Caller{
+		File:     &file,
+		Line:     &l,
+		Function: &function,
+	}
+}
+
+// Short method will return the Caller's file (with its parent directory) and line, as
+// `dir/file.go:42`
+func (x *Caller) Short() string {
+	if x == nil {
+		return ""
+	}
+
+	file := x.GetFile()
+	dir, base := path.Split(file)
+
+	if dir != "" {
+		base = path.Join(path.Base(dir), base)
+	}
+
+	return base + ":" + strconv.Itoa(int(x.GetLine()))
+}
+
+// Caller method will set the source location of the function `skip` frames above the one
+// calling this method as the EventBuilder's caller, and return the builder
+func (b *EventBuilder) Caller(skip int) *EventBuilder {
+	b.BCaller = NewCaller(skip + 1)
+	return b
+}
@@ -0,0 +1,33 @@
+package event
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestCaller(t *testing.T) {
+	module := "EventBuilder"
+	funcname := "Caller()"
+
+	_, file, line, _ := runtime.Caller(0)
+	e := New().Message("null").Caller(0).Build()
+
+	c := e.GetCaller()
+
+	if c.GetFile() != file || c.GetLine() != int32(line+1) {
+		t.Errorf("[%s] [%s] location mismatch: wanted %s:%v ; got %s:%v", module, funcname, file, line+1, c.GetFile(), c.GetLine())
+	}
+
+	if !strings.HasSuffix(c.GetFunction(), "event.TestCaller") {
+		t.Errorf("[%s] [%s] unexpected function name: %s", module, funcname, c.GetFunction())
+	}
+
+	if short := c.Short(); !strings.HasPrefix(short, "event/caller_test.go:") {
+		t.Errorf("[%s] [Short()] unexpected short location: %s", module, short)
+	}
+
+	if New().Message("null").Build().GetCaller() != nil {
+		t.Errorf("[%s] [%s] expected no caller by default", module, funcname)
+	}
+}
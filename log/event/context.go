@@ -0,0 +1,69 @@
+package event
+
+import "context"
+
+type fieldsCtxKey struct{}
+
+// NewContext function will return a copy of the input context.Context, carrying the input
+// metadata fields as request-scoped data.
+//
+// If the parent context already carries metadata fields, these are merged with the new ones
+// (new keys replacing existing ones) without modifying the parent context's map
+func NewContext(ctx context.Context, fields map[string]interface{}) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	merged := Field{}
+
+	for k, v := range FromContext(ctx) {
+		merged[k] = v
+	}
+
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	return context.WithValue(ctx, fieldsCtxKey{}, merged)
+}
+
+// FromContext function will return the metadata fields carried by the input context.Context,
+// or nil if it does not contain any
+func FromContext(ctx context.Context) Field {
+	if ctx == nil {
+		return nil
+	}
+
+	if f, ok := ctx.Value(fieldsCtxKey{}).(Field); ok {
+		return f
+	}
+
+	return nil
+}
+
+// Ctx method will add the metadata fields carried by the input context.Context (if any) to the
+// EventBuilder's metadata, and return the builder
+//
+// Fields already present in the EventBuilder take precedence over the ones in the context
+func (b *EventBuilder) Ctx(ctx context.Context) *EventBuilder {
+	fields := FromContext(ctx)
+
+	if len(fields) == 0 {
+		return b
+	}
+
+	meta := map[string]interface{}{}
+
+	for k, v := range fields {
+		meta[k] = v
+	}
+
+	if b.BMetadata != nil {
+		for k, v := range *b.BMetadata {
+			meta[k] = v
+		}
+	}
+
+	b.BMetadata = &meta
+	return b
+}
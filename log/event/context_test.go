@@ -0,0 +1,137 @@
+package event
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	module := "Event"
+	funcname := "NewContext()"
+
+	type test struct {
+		name   string
+		ctx    context.Context
+		fields map[string]interface{}
+		wants  Field
+	}
+
+	var tests = []test{
+		{
+			name:   "fields in an empty context",
+			ctx:    context.Background(),
+			fields: map[string]interface{}{"a": 1},
+			wants:  Field{"a": 1},
+		},
+		{
+			name:   "fields merged with a parent context's fields",
+			ctx:    NewContext(context.Background(), map[string]interface{}{"a": 1, "b": 2}),
+			fields: map[string]interface{}{"b": 3, "c": 4},
+			wants:  Field{"a": 1, "b": 3, "c": 4},
+		},
+		{
+			name:  "nil context",
+			ctx:   nil,
+			wants: Field{},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		ctx := NewContext(test.ctx, test.fields)
+
+		if f := FromContext(ctx); !reflect.DeepEqual(f, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				f,
+				test.name,
+			)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// parent context must remain untouched
+	parent := NewContext(context.Background(), map[string]interface{}{"a": 1})
+	_ = NewContext(parent, map[string]interface{}{"a": 2})
+
+	if v := FromContext(parent)["a"]; v != 1 {
+		t.Errorf("[%s] [%s] parent context was modified: wanted %v ; got %v", module, funcname, 1, v)
+	}
+}
+
+func TestCtx(t *testing.T) {
+	module := "EventBuilder"
+	funcname := "Ctx()"
+
+	type test struct {
+		name  string
+		ctx   context.Context
+		meta  map[string]interface{}
+		wants map[string]interface{}
+	}
+
+	var tests = []test{
+		{
+			name:  "context without fields",
+			ctx:   context.Background(),
+			meta:  map[string]interface{}{"a": "b"},
+			wants: map[string]interface{}{"a": "b"},
+		},
+		{
+			name:  "context fields on an event without metadata",
+			ctx:   NewContext(context.Background(), map[string]interface{}{"req": "id"}),
+			wants: map[string]interface{}{"req": "id"},
+		},
+		{
+			name:  "event metadata takes precedence",
+			ctx:   NewContext(context.Background(), map[string]interface{}{"req": "id", "a": "ctx"}),
+			meta:  map[string]interface{}{"a": "b"},
+			wants: map[string]interface{}{"req": "id", "a": "b"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		e := New().Message("test").Metadata(test.meta).Ctx(test.ctx).Build()
+
+		if !reflect.DeepEqual(e.GetMeta().AsMap(), test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				e.GetMeta().AsMap(),
+				test.name,
+			)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
@@ -0,0 +1,143 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/zalgonoise/zlog/log/trace"
+)
+
+// NewError function will convert the input error into an Error, containing its message, its
+// concrete type name, the chain of wrapped errors (as returned by errors.Unwrap) and a stack
+// trace if any error in the chain carries one. It returns nil if the input error is nil.
+//
+// Stack traces are retrieved from errors implementing one of:
+//
+//	interface{ StackTrace() T }        // such as github.com/pkg/errors, formatted with %+v
+//	interface{ Stack() []byte }
+//	interface{ Stack() string }
+//	interface{ Callers() []uintptr }
+//
+// The innermost stack trace in the chain is used, as it is the closest to the error's origin
+func NewError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	typ := typeName(err)
+	stack := stackOf(err)
+
+	e := &Error{
+		Message: &msg,
+		Type:    &typ,
+	}
+
+	for inner := errors.Unwrap(err); inner != nil; inner = errors.Unwrap(inner) {
+		imsg := inner.Error()
+		ityp := typeName(inner)
+
+		e.Chain = append(e.Chain, &ErrorCause{
+			Message: &imsg,
+			Type:    &ityp,
+		})
+
+		if s := stackOf(inner); s != "" {
+			stack = s
+		}
+	}
+
+	if stack != "" {
+		e.Stack = &stack
+	}
+
+	return e
+}
+
+// Err method will set the input error as the EventBuilder's error, and return the builder.
+//
+// If the EventBuilder has no message set, the error's message is used instead
+func (b *EventBuilder) Err(err error) *EventBuilder {
+	if err == nil {
+		return b
+	}
+
+	b.BError = NewError(err)
+
+	if b.BMsg == "" {
+		b.BMsg = err.Error()
+	}
+
+	return b
+}
+
+func typeName(err error) string {
+	return fmt.Sprintf("%T", err)
+}
+
+func stackOf(err error) string {
+	switch e := err.(type) {
+	case interface{ Stack() []byte }:
+		return string(e.Stack())
+	case interface{ Stack() string }:
+		return e.Stack()
+	case interface{ Callers() []uintptr }:
+		return fmtCallers(e.Callers())
+	}
+
+	// match StackTrace() methods regardless of their return type
+	m := reflect.ValueOf(err).MethodByName("StackTrace")
+
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return ""
+	}
+
+	return strings.TrimLeft(fmt.Sprintf("%+v", m.Call(nil)[0].Interface()), "\n")
+}
+
+func fmtCallers(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	// symbolized frames are cached by the trace package
+	for _, f := range trace.Frames(pcs) {
+		sb.WriteString(f.Function)
+		sb.WriteString("\n\t")
+		sb.WriteString(f.File)
+		sb.WriteString(":")
+		sb.WriteString(strconv.Itoa(f.Line))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// NewErrorFrom function will create an Error from its message, type name and stack trace (which
+// is left unset if empty), with the input ErrorCauses as its chain. It is used when decoding events
+func NewErrorFrom(message, typ, stack string, chain ...*ErrorCause) *Error {
+	e := &Error{
+		Message: &message,
+		Type:    &typ,
+		Chain:   chain,
+	}
+
+	if stack != "" {
+		e.Stack = &stack
+	}
+
+	return e
+}
+
+// NewErrorCause function will create an ErrorCause from its message and type name
+func NewErrorCause(message, typ string) *ErrorCause {
+	return &ErrorCause{
+		Message: &message,
+		Type:    &typ,
+	}
+}
@@ -0,0 +1,127 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type stackError struct {
+	msg   string
+	stack []byte
+}
+
+func (e *stackError) Error() string { return e.msg }
+func (e *stackError) Stack() []byte { return e.stack }
+
+func TestNewError(t *testing.T) {
+	module := "Error"
+	funcname := "NewError()"
+
+	type test struct {
+		name  string
+		err   error
+		msg   string
+		typ   string
+		chain []string
+		stack string
+	}
+
+	var tests = []test{
+		{
+			name: "nil error",
+		},
+		{
+			name: "simple error",
+			err:  errors.New("null"),
+			msg:  "null",
+			typ:  "*errors.errorString",
+		},
+		{
+			name:  "wrapped error",
+			err:   fmt.Errorf("outer: %w", fmt.Errorf("middle: %w", errors.New("inner"))),
+			msg:   "outer: middle: inner",
+			typ:   "*fmt.wrapError",
+			chain: []string{"middle: inner", "inner"},
+		},
+		{
+			name:  "wrapped error with stack",
+			err:   fmt.Errorf("outer: %w", &stackError{msg: "inner", stack: []byte("main.main()\n")}),
+			msg:   "outer: inner",
+			typ:   "*fmt.wrapError",
+			chain: []string{"inner"},
+			stack: "main.main()\n",
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		e := NewError(test.err)
+
+		if test.err == nil {
+			if e != nil {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] -- expected nil Error; got %v -- action: %s",
+					idx, module, funcname, e, test.name,
+				)
+			}
+			return
+		}
+
+		if e.GetMessage() != test.msg || e.GetType() != test.typ || e.GetStack() != test.stack {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] -- output mismatch error: wanted %q (%s) ; got %q (%s) -- action: %s",
+				idx, module, funcname, test.msg, test.typ, e.GetMessage(), e.GetType(), test.name,
+			)
+			return
+		}
+
+		if len(e.GetChain()) != len(test.chain) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] -- chain length mismatch: wanted %v ; got %v -- action: %s",
+				idx, module, funcname, len(test.chain), len(e.GetChain()), test.name,
+			)
+			return
+		}
+
+		for i, c := range e.GetChain() {
+			if c.GetMessage() != test.chain[i] {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] -- chain item #%v mismatch: wanted %s ; got %s -- action: %s",
+					idx, module, funcname, i, test.chain[i], c.GetMessage(), test.name,
+				)
+				return
+			}
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestBuilderErr(t *testing.T) {
+	module := "EventBuilder"
+	funcname := "Err()"
+
+	e := New().Err(errors.New("null")).Build()
+
+	if e.GetMsg() != "null" || e.GetError().GetMessage() != "null" {
+		t.Errorf("[%s] [%s] expected the error's message to be used; got %q", module, funcname, e.GetMsg())
+	}
+
+	e = New().Message("failed").Err(errors.New("null")).Build()
+
+	if e.GetMsg() != "failed" {
+		t.Errorf("[%s] [%s] expected the message to be kept; got %q", module, funcname, e.GetMsg())
+	}
+
+	if e = New().Message("null").Err(nil).Build(); e.GetError() != nil {
+		t.Errorf("[%s] [%s] expected no error with a nil input; got %v", module, funcname, e.GetError())
+	}
+
+	if e := NewErrorFrom("null", "test", "", NewErrorCause("inner", "test")); e.GetMessage() != "null" || e.GetStack() != "" || len(e.GetChain()) != 1 {
+		t.Errorf("[%s] [NewErrorFrom()] unexpected output: %v", module, e)
+	}
+}
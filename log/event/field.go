@@ -1,7 +1,16 @@
 package event
 
 import (
+	"encoding"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -18,18 +27,24 @@ func (f Field) AsMap() map[string]interface{} {
 // ToStructPB method will convert the metadata in the protobuf Event as a pointer to a
 // structpb.Struct, returning this and an error if any.
 //
-// The metadata (a map[string]interface{}) is converted to JSON (bytes), and this data is
-// unmarshalled into a *structpb.Struct object.
+// Each value in the metadata (a map[string]interface{}) is converted directly into a
+// *structpb.Value (see ToValue), matching the output of its JSON representation. It
+// returns a nil *structpb.Struct if the Field is nil
 func (f Field) ToStructPB() (*structpb.Struct, error) {
-	b, err := json.Marshal(f.AsMap())
-	if err != nil {
-		return nil, err
+	if f == nil {
+		return nil, nil
 	}
 
-	s := &structpb.Struct{}
-	err = protojson.Unmarshal(b, s)
-	if err != nil {
-		return nil, err
+	s := &structpb.Struct{
+		Fields: make(map[string]*structpb.Value, len(f)),
+	}
+
+	for k, v := range f {
+		value, err := ToValue(v)
+		if err != nil {
+			return nil, err
+		}
+		s.Fields[k] = value
 	}
 	return s, nil
 }
@@ -39,3 +54,324 @@ func (f Field) Encode() *structpb.Struct {
 	s, _ := f.ToStructPB()
 	return s
 }
+
+// Attr struct is a typed metadata field, holding a key and its (already converted) value.
+//
+// Attrs are created with the typed constructors in this package (String(), Int(), Dur(),
+// Time(), Object(), ...) and added to an event with EventBuilder.Attrs(); which avoids
+// converting the values in a map[string]interface{} when the event is built.
+//
+// Attrs of types that a structpb.Value cannot hold exactly also carry their lossless Value
+// in Data, which is placed in the Event's data
+type Attr struct {
+	Key   string
+	Value *structpb.Value
+	Data  *Value
+}
+
+// String function creates an Attr with a string value
+func String(key, value string) Attr {
+	return Attr{Key: key, Value: structpb.NewStringValue(validString(value))}
+}
+
+// Strings function creates an Attr with a list of string values
+func Strings(key string, values ...string) Attr {
+	list := make([]*structpb.Value, len(values))
+
+	for idx, v := range values {
+		list[idx] = structpb.NewStringValue(validString(v))
+	}
+
+	return Attr{Key: key, Value: structpb.NewListValue(&structpb.ListValue{Values: list})}
+}
+
+// Bool function creates an Attr with a boolean value
+func Bool(key string, value bool) Attr {
+	return Attr{Key: key, Value: structpb.NewBoolValue(value)}
+}
+
+// Int function creates an Attr with an int value
+func Int(key string, value int) Attr {
+	data, _ := NewValue(value)
+	return Attr{Key: key, Value: structpb.NewNumberValue(float64(value)), Data: data}
+}
+
+// Int64 function creates an Attr with an int64 value
+func Int64(key string, value int64) Attr {
+	data, _ := NewValue(value)
+	return Attr{Key: key, Value: structpb.NewNumberValue(float64(value)), Data: data}
+}
+
+// Uint64 function creates an Attr with an uint64 value
+func Uint64(key string, value uint64) Attr {
+	data, _ := NewValue(value)
+	return Attr{Key: key, Value: structpb.NewNumberValue(float64(value)), Data: data}
+}
+
+// Float64 function creates an Attr with a float64 value
+func Float64(key string, value float64) Attr {
+	return Attr{Key: key, Value: structpb.NewNumberValue(value)}
+}
+
+// Dur function creates an Attr with a time.Duration value, which is stored as its number of
+// nanoseconds; the same as a time.Duration in a metadata map
+func Dur(key string, value time.Duration) Attr {
+	data, _ := NewValue(value)
+	return Attr{Key: key, Value: structpb.NewNumberValue(float64(value)), Data: data}
+}
+
+// Time function creates an Attr with a time.Time value, which is stored as an
+// RFC3339 timestamp with nanoseconds
+func Time(key string, value time.Time) Attr {
+	data, _ := NewValue(value)
+	return Attr{Key: key, Value: structpb.NewStringValue(value.Format(time.RFC3339Nano)), Data: data}
+}
+
+// Object function creates an Attr with a nested object, composed of the input Attrs. The lossless
+// Values of the input Attrs (if any) are kept in a map Value
+func Object(key string, attrs ...Attr) Attr {
+	var (
+		data map[string]*Value
+		s    = &structpb.Struct{
+			Fields: make(map[string]*structpb.Value, len(attrs)),
+		}
+	)
+
+	for _, a := range attrs {
+		s.Fields[a.Key] = a.Value
+
+		if a.Data != nil {
+			if data == nil {
+				data = map[string]*Value{}
+			}
+
+			data[a.Key] = a.Data
+		}
+	}
+
+	value, _ := NewMapValue(data)
+
+	return Attr{Key: key, Value: structpb.NewStructValue(s), Data: value}
+}
+
+// Any function creates an Attr from any value, converted with ToValue. If the value cannot
+// be converted, the Attr will hold its fmt.Sprint() representation instead
+func Any(key string, value interface{}) Attr {
+	v, err := ToValue(value)
+	if err != nil {
+		v = structpb.NewStringValue(validString(fmt.Sprint(value)))
+	}
+
+	data, _ := NewValue(value)
+	return Attr{Key: key, Value: v, Data: data}
+}
+
+// ToValue function converts the input value into a *structpb.Value.
+//
+// Common types (booleans, numbers, strings, byte slices, time values, maps and slices of
+// these) are converted directly. Any other type is converted through its JSON representation,
+// so the output is the same as marshalling the value to JSON and unmarshalling it into a
+// *structpb.Value
+func ToValue(v interface{}) (*structpb.Value, error) {
+	switch t := v.(type) {
+	case nil:
+		return structpb.NewNullValue(), nil
+	case *structpb.Value:
+		return t, nil
+	case Attr:
+		return t.Value, nil
+	case bool:
+		return structpb.NewBoolValue(t), nil
+	case string:
+		return structpb.NewStringValue(validString(t)), nil
+	case []byte:
+		if t == nil {
+			return structpb.NewNullValue(), nil
+		}
+		return structpb.NewStringValue(base64.StdEncoding.EncodeToString(t)), nil
+	case int:
+		return structpb.NewNumberValue(float64(t)), nil
+	case int8:
+		return structpb.NewNumberValue(float64(t)), nil
+	case int16:
+		return structpb.NewNumberValue(float64(t)), nil
+	case int32:
+		return structpb.NewNumberValue(float64(t)), nil
+	case int64:
+		return structpb.NewNumberValue(float64(t)), nil
+	case uint:
+		return structpb.NewNumberValue(float64(t)), nil
+	case uint8:
+		return structpb.NewNumberValue(float64(t)), nil
+	case uint16:
+		return structpb.NewNumberValue(float64(t)), nil
+	case uint32:
+		return structpb.NewNumberValue(float64(t)), nil
+	case uint64:
+		return structpb.NewNumberValue(float64(t)), nil
+	case float32:
+		// keep the shortest representation of the float32, as encoding/json does
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(t), 'g', -1, 32), 64)
+		return numberValue(f)
+	case float64:
+		return numberValue(t)
+	case time.Duration:
+		return structpb.NewNumberValue(float64(t)), nil
+	case time.Time:
+		return structpb.NewStringValue(t.Format(time.RFC3339Nano)), nil
+	case Field:
+		return structValue(t)
+	case map[string]interface{}:
+		return structValue(t)
+	case []interface{}:
+		if t == nil {
+			return structpb.NewNullValue(), nil
+		}
+
+		list := make([]*structpb.Value, len(t))
+
+		for idx, item := range t {
+			value, err := ToValue(item)
+			if err != nil {
+				return nil, err
+			}
+			list[idx] = value
+		}
+
+		return structpb.NewListValue(&structpb.ListValue{Values: list}), nil
+	case []string:
+		if t == nil {
+			return structpb.NewNullValue(), nil
+		}
+
+		list := make([]*structpb.Value, len(t))
+
+		for idx, item := range t {
+			list[idx] = structpb.NewStringValue(validString(item))
+		}
+
+		return structpb.NewListValue(&structpb.ListValue{Values: list}), nil
+	case []map[string]interface{}:
+		if t == nil {
+			return structpb.NewNullValue(), nil
+		}
+
+		list := make([]*structpb.Value, len(t))
+
+		for idx, item := range t {
+			value, err := structValue(item)
+			if err != nil {
+				return nil, err
+			}
+			list[idx] = value
+		}
+
+		return structpb.NewListValue(&structpb.ListValue{Values: list}), nil
+	default:
+		return reflectValue(v)
+	}
+}
+
+// reflectValue converts slices, arrays and string-keyed maps of any type, element by element.
+// Any other value (or one that implements its own JSON marshaller) is converted through its
+// JSON representation
+func reflectValue(v interface{}) (*structpb.Value, error) {
+	switch v.(type) {
+	case json.Marshaler, encoding.TextMarshaler:
+		return jsonValue(v)
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return structpb.NewNullValue(), nil
+		}
+
+		// byte slices are encoded as base64 strings
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return jsonValue(v)
+		}
+
+		list := make([]*structpb.Value, rv.Len())
+
+		for idx := range list {
+			value, err := ToValue(rv.Index(idx).Interface())
+			if err != nil {
+				return nil, err
+			}
+			list[idx] = value
+		}
+
+		return structpb.NewListValue(&structpb.ListValue{Values: list}), nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return jsonValue(v)
+		}
+
+		if rv.IsNil() {
+			return structpb.NewNullValue(), nil
+		}
+
+		s := &structpb.Struct{
+			Fields: make(map[string]*structpb.Value, rv.Len()),
+		}
+
+		iter := rv.MapRange()
+		for iter.Next() {
+			value, err := ToValue(iter.Value().Interface())
+			if err != nil {
+				return nil, err
+			}
+			s.Fields[iter.Key().String()] = value
+		}
+
+		return structpb.NewStructValue(s), nil
+	default:
+		return jsonValue(v)
+	}
+}
+
+func structValue(m map[string]interface{}) (*structpb.Value, error) {
+	if m == nil {
+		return structpb.NewNullValue(), nil
+	}
+
+	s, err := Field(m).ToStructPB()
+	if err != nil {
+		return nil, err
+	}
+
+	return structpb.NewStructValue(s), nil
+}
+
+func numberValue(f float64) (*structpb.Value, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("unsupported number value: %v", f)
+	}
+
+	return structpb.NewNumberValue(f), nil
+}
+
+func jsonValue(v interface{}) (*structpb.Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	value := &structpb.Value{}
+	if err = protojson.Unmarshal(b, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// validString replaces invalid UTF-8 sequences, as protobuf strings must be valid UTF-8
+func validString(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+
+	return strings.ToValidUTF8(s, "\uFFFD")
+}
@@ -0,0 +1,154 @@
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestToValue(t *testing.T) {
+	module := "Field"
+	funcname := "ToValue()"
+
+	type custom struct {
+		A int    `json:"a"`
+		B string `json:"b,omitempty"`
+	}
+
+	type test struct {
+		name  string
+		value interface{}
+	}
+
+	var tests = []test{
+		{name: "nil value", value: nil},
+		{name: "boolean", value: true},
+		{name: "string", value: "null"},
+		{name: "invalid UTF-8 string", value: "null\xff"},
+		{name: "byte slice", value: []byte("null")},
+		{name: "nil byte slice", value: []byte(nil)},
+		{name: "int", value: 42},
+		{name: "int8", value: int8(-8)},
+		{name: "int64", value: int64(1 << 40)},
+		{name: "uint32", value: uint32(32)},
+		{name: "float32", value: float32(0.1)},
+		{name: "float64", value: 3.14},
+		{name: "duration", value: 1500 * time.Millisecond},
+		{name: "time", value: time.Date(2022, 8, 12, 10, 0, 0, 123, time.UTC)},
+		{name: "nested map", value: map[string]interface{}{"a": true, "b": map[string]interface{}{"c": 1}}},
+		{name: "Field", value: Field{"a": []interface{}{1, "b", nil}}},
+		{name: "nil map", value: map[string]interface{}(nil)},
+		{name: "string slice", value: []string{"a", "b"}},
+		{name: "nil string slice", value: []string(nil)},
+		{name: "int slice", value: []int{1, 2, 3}},
+		{name: "int array", value: [2]int{1, 2}},
+		{name: "map slice", value: []map[string]interface{}{{"a": 1}, {"b": "c"}}},
+		{name: "string map", value: map[string]string{"a": "b"}},
+		{name: "nil string map", value: map[string]string(nil)},
+		{name: "int-keyed map", value: map[int]string{1: "a"}},
+		{name: "named byte slice", value: json.RawMessage(`{"a":1}`)},
+		{name: "custom struct", value: custom{A: 1}},
+		{name: "error", value: errors.New("null")},
+	}
+
+	var verify = func(idx int, test test) {
+		wants, err := jsonValue(test.value)
+		if err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] -- JSON conversion error: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		v, err := ToValue(test.value)
+		if err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] -- conversion error: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		if !proto.Equal(wants, v) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] -- output mismatch error: wanted %v ; got %v -- action: %s", idx, module, funcname, wants, v, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	for _, v := range []interface{}{math.NaN(), math.Inf(1), func() {}} {
+		if _, err := ToValue(v); err == nil {
+			t.Errorf("[%s] [%s] expected an error converting %T", module, funcname, v)
+		}
+	}
+}
+
+func TestAttr(t *testing.T) {
+	module := "Attr"
+	funcname := "Attr constructors"
+
+	ts := time.Date(2022, 8, 12, 10, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name  string
+		attr  Attr
+		wants *structpb.Value
+	}
+
+	var tests = []test{
+		{name: "String()", attr: String("k", "v"), wants: structpb.NewStringValue("v")},
+		{name: "Strings()", attr: Strings("k", "a", "b"), wants: structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("a"), structpb.NewStringValue("b")}})},
+		{name: "Bool()", attr: Bool("k", true), wants: structpb.NewBoolValue(true)},
+		{name: "Int()", attr: Int("k", 1), wants: structpb.NewNumberValue(1)},
+		{name: "Int64()", attr: Int64("k", -1), wants: structpb.NewNumberValue(-1)},
+		{name: "Uint64()", attr: Uint64("k", 1), wants: structpb.NewNumberValue(1)},
+		{name: "Float64()", attr: Float64("k", 0.5), wants: structpb.NewNumberValue(0.5)},
+		{name: "Dur()", attr: Dur("k", 1500*time.Millisecond), wants: structpb.NewNumberValue(1.5e9)},
+		{name: "Any() with a time.Duration", attr: Any("k", 1500*time.Millisecond), wants: structpb.NewNumberValue(1.5e9)},
+		{name: "Time()", attr: Time("k", ts), wants: structpb.NewStringValue("2022-08-12T10:00:00Z")},
+		{name: "Object()", attr: Object("k", Int("a", 1), String("b", "c")), wants: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNumberValue(1), "b": structpb.NewStringValue("c")}})},
+		{name: "Any()", attr: Any("k", map[string]interface{}{"a": 1}), wants: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNumberValue(1)}})},
+		{name: "Any() with unsupported type", attr: Any("k", math.NaN()), wants: structpb.NewStringValue("NaN")},
+	}
+
+	var verify = func(idx int, test test) {
+		if test.attr.Key != "k" || !proto.Equal(test.wants, test.attr.Value) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] -- output mismatch error: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, test.attr.Value, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestAttrs(t *testing.T) {
+	module := "EventBuilder"
+	funcname := "Attrs()"
+
+	meta := map[string]interface{}{"a": 1, "b": "map"}
+
+	e := New().Message("null").Metadata(meta).Attrs(String("b", "attr"), Bool("c", true)).Build()
+
+	wants := Field{"a": 1, "b": "attr", "c": true}.Encode()
+
+	if !proto.Equal(wants, e.GetMeta()) {
+		t.Errorf("[%s] [%s] output mismatch error: wanted %v ; got %v", module, funcname, wants, e.GetMeta())
+	}
+
+	if len(meta) != 2 || meta["b"] != "map" {
+		t.Errorf("[%s] [%s] input metadata map was modified: %v", module, funcname, meta)
+	}
+
+	if e = New().Message("null").Attrs().Build(); len(e.GetMeta().GetFields()) != 0 {
+		t.Errorf("[%s] [%s] expected no metadata; got %v", module, funcname, e.GetMeta())
+	}
+}
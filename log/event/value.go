@@ -0,0 +1,135 @@
+package event
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewValue function converts the input value into a (lossless) Value, if it is of a type that
+// cannot be represented exactly in the Event's metadata (a structpb.Struct, which holds numbers
+// as float64): int, int64, uint, uint64, time.Time, time.Duration and []byte. Nested maps holding
+// any of these are converted into a map Value with the entries that need it.
+//
+// It returns false for any other type, as these do not need a typed representation
+func NewValue(v interface{}) (*Value, bool) {
+	switch t := v.(type) {
+	case Attr:
+		return t.Data, t.Data != nil
+	case map[string]interface{}:
+		return NewMapValue(Field(t).Data())
+	case Field:
+		return NewMapValue(t.Data())
+	case int:
+		return &Value{Kind: &Value_IntValue{IntValue: int64(t)}}, true
+	case int64:
+		return &Value{Kind: &Value_IntValue{IntValue: t}}, true
+	case uint:
+		return &Value{Kind: &Value_UintValue{UintValue: uint64(t)}}, true
+	case uint64:
+		return &Value{Kind: &Value_UintValue{UintValue: t}}, true
+	case []byte:
+		if t == nil {
+			return nil, false
+		}
+		return &Value{Kind: &Value_BytesValue{BytesValue: t}}, true
+	case time.Time:
+		return &Value{Kind: &Value_TimeValue{TimeValue: timestamppb.New(t)}}, true
+	case time.Duration:
+		return &Value{Kind: &Value_DurationValue{DurationValue: durationpb.New(t)}}, true
+	default:
+		return nil, false
+	}
+}
+
+// NewMapValue function wraps the input Values (keyed as in a nested metadata map) in a map Value.
+// It returns false if there are none
+func NewMapValue(values map[string]*Value) (*Value, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	return &Value{Kind: &Value_MapValue{MapValue: &ValueMap{Values: values}}}, true
+}
+
+// Interface method returns the Value as a Go type: an int64, uint64, []byte, time.Time or
+// time.Duration; or a map[string]interface{} of these for a map Value. It returns nil if the
+// Value is unset
+func (x *Value) Interface() interface{} {
+	switch k := x.GetKind().(type) {
+	case *Value_MapValue:
+		values := make(map[string]interface{}, len(k.MapValue.GetValues()))
+
+		for key, v := range k.MapValue.GetValues() {
+			values[key] = v.Interface()
+		}
+
+		return values
+	case *Value_IntValue:
+		return k.IntValue
+	case *Value_UintValue:
+		return k.UintValue
+	case *Value_BytesValue:
+		return k.BytesValue
+	case *Value_TimeValue:
+		return k.TimeValue.AsTime()
+	case *Value_DurationValue:
+		return k.DurationValue.AsDuration()
+	default:
+		return nil
+	}
+}
+
+// Data method returns the (lossless) Values for the entries in the Field that need them, as
+// described in NewValue. It returns nil if there are none
+func (f Field) Data() map[string]*Value {
+	var data map[string]*Value
+
+	for k, v := range f {
+		if value, ok := NewValue(v); ok {
+			if data == nil {
+				data = map[string]*Value{}
+			}
+			data[k] = value
+		}
+	}
+
+	return data
+}
+
+// Metadata method returns the Event's metadata as a map[string]interface{}, like
+// Event.GetMeta().AsMap(); but with the entries carried in the Event's data (including those in
+// nested maps) keeping their original type (int64, uint64, []byte, time.Time or time.Duration)
+// instead of their float64 or string representation
+func (x *Event) Metadata() map[string]interface{} {
+	meta := x.GetMeta().AsMap()
+
+	mergeData(meta, x.GetData())
+
+	return meta
+}
+
+// mergeData function replaces the entries in the input metadata with their typed Values, descending
+// into nested maps for map Values
+func mergeData(meta map[string]interface{}, data map[string]*Value) {
+	for k, v := range data {
+		if m := v.GetMapValue(); m != nil {
+			if nested, ok := meta[k].(map[string]interface{}); ok {
+				mergeData(nested, m.GetValues())
+			}
+
+			continue
+		}
+
+		if value := v.Interface(); value != nil {
+			meta[k] = value
+		}
+	}
+}
+
+// SetMetadata method will replace the Event's metadata (and its typed data) with the input map
+func (x *Event) SetMetadata(meta map[string]interface{}) {
+	x.Meta = Field(meta).Encode()
+	x.Data = Field(meta).Data()
+}
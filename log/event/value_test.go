@@ -0,0 +1,120 @@
+package event
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewValue(t *testing.T) {
+	module := "Value"
+	funcname := "NewValue()"
+
+	type test struct {
+		name  string
+		value interface{}
+		wants interface{}
+		ok    bool
+	}
+
+	var ts = time.Unix(1660298400, 123456789).UTC()
+
+	var tests = []test{
+		{name: "int", value: 42, wants: int64(42), ok: true},
+		{name: "int64", value: int64(1<<62 + 1), wants: int64(1<<62 + 1), ok: true},
+		{name: "uint", value: uint(7), wants: uint64(7), ok: true},
+		{name: "uint64", value: uint64(math.MaxUint64), wants: uint64(math.MaxUint64), ok: true},
+		{name: "bytes", value: []byte("null"), wants: []byte("null"), ok: true},
+		{name: "time", value: ts, wants: ts, ok: true},
+		{name: "duration", value: 1500 * time.Nanosecond, wants: 1500 * time.Nanosecond, ok: true},
+		{name: "typed Attr", value: Int64("k", 1), wants: int64(1), ok: true},
+		{name: "untyped Attr", value: String("k", "v")},
+		{name: "int32", value: int32(1)},
+		{name: "float64", value: 0.5},
+		{name: "string", value: "null"},
+		{name: "nil bytes", value: []byte(nil)},
+	}
+
+	var verify = func(idx int, test test) {
+		v, ok := NewValue(test.value)
+
+		if ok != test.ok {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] -- unexpected result: wanted %v ; got %v -- action: %s", idx, module, funcname, test.ok, ok, test.name)
+			return
+		}
+
+		if ok && !reflect.DeepEqual(v.Interface(), test.wants) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] -- output mismatch error: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, v.Interface(), test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestEventMetadata(t *testing.T) {
+	module := "Event"
+	funcname := "Metadata()"
+
+	ts := time.Unix(1660298400, 123456789).UTC()
+
+	e := New().Message("null").Metadata(map[string]interface{}{
+		"id":   int64(1<<62 + 1),
+		"time": ts,
+		"a":    true,
+	}).Attrs(Dur("elapsed", time.Second), String("b", "c")).Build()
+
+	if len(e.GetData()) != 3 {
+		t.Errorf("[%s] [%s] expected 3 typed values; got %v", module, funcname, e.GetData())
+	}
+
+	wants := map[string]interface{}{
+		"id":      int64(1<<62 + 1),
+		"time":    ts,
+		"elapsed": time.Second,
+		"a":       true,
+		"b":       "c",
+	}
+
+	if meta := e.Metadata(); !reflect.DeepEqual(wants, meta) {
+		t.Errorf("[%s] [%s] output mismatch error: wanted %v ; got %v", module, funcname, wants, meta)
+	}
+
+	// the structpb metadata keeps the lossy representation
+	if e.GetMeta().AsMap()["id"] != float64(1<<62+1) {
+		t.Errorf("[%s] [%s] unexpected metadata value: %v", module, funcname, e.GetMeta().AsMap()["id"])
+	}
+
+	// a later Metadata() call replaces the typed value
+	e = New().Message("null").Attrs(Int("id", 1)).Metadata(map[string]interface{}{"id": "one"}).Build()
+
+	if len(e.GetData()) != 0 || e.Metadata()["id"] != "one" {
+		t.Errorf("[%s] [%s] expected the typed value to be replaced; got %v", module, funcname, e.Metadata())
+	}
+
+	e.SetMetadata(map[string]interface{}{"id": uint64(math.MaxUint64)})
+
+	if !proto.Equal(e.GetData()["id"], &Value{Kind: &Value_UintValue{UintValue: math.MaxUint64}}) {
+		t.Errorf("[%s] [SetMetadata()] unexpected typed value: %v", module, e.GetData())
+	}
+	// nested objects keep their typed values, as the flat form does
+	e = New().Message("null").Attrs(Object("req", Int64("id", 1<<62+1), String("path", "/"))).Metadata(map[string]interface{}{
+		"res": map[string]interface{}{"status": 200, "ok": true},
+	}).Build()
+
+	wants = map[string]interface{}{
+		"req": map[string]interface{}{"id": int64(1<<62 + 1), "path": "/"},
+		"res": map[string]interface{}{"status": int64(200), "ok": true},
+	}
+
+	if meta := e.Metadata(); !reflect.DeepEqual(wants, meta) {
+		t.Errorf("[%s] [%s] output mismatch error: wanted %v ; got %v", module, funcname, wants, meta)
+	}
+}
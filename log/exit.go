@@ -0,0 +1,278 @@
+package log
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const defaultExitTimeout time.Duration = 5 * time.Second
+
+// CloserFunc type is an adapter to use a function as an io.Closer, such as the Close() method of
+// a type that does not return an error:
+//
+//	log.WithCloser(log.CloserFunc(func() error {
+//	    client.Close()
+//	    return nil
+//	}))
+type CloserFunc func() error
+
+// Close method implements the io.Closer interface, calling the CloserFunc
+func (fn CloserFunc) Close() error {
+	return fn()
+}
+
+// LCExitFunc struct is a custom LoggerConfig to replace the os.Exit() call for fatal events
+type LCExitFunc struct {
+	fn func(code int)
+}
+
+// LCPanicFunc struct is a custom LoggerConfig to replace the panic() call for panic events
+type LCPanicFunc struct {
+	fn func(v interface{})
+}
+
+// LCCloser struct is a custom LoggerConfig to register io.Closers in new Loggers, which are
+// closed before the Logger exits or panics
+type LCCloser struct {
+	closers []io.Closer
+}
+
+// LCExitTimeout struct is a custom LoggerConfig to define how long a Logger waits for its queue
+// and io.Closers before it exits or panics
+type LCExitTimeout struct {
+	d time.Duration
+}
+
+// Apply method will set the configured exit function to the input pointer to a LoggerBuilder
+func (c *LCExitFunc) Apply(lb *LoggerBuilder) {
+	lb.ExitFunc = c.fn
+}
+
+// Apply method will set the configured panic function to the input pointer to a LoggerBuilder
+func (c *LCPanicFunc) Apply(lb *LoggerBuilder) {
+	lb.PanicFunc = c.fn
+}
+
+// Apply method will add the configured io.Closers to the input pointer to a LoggerBuilder
+func (c *LCCloser) Apply(lb *LoggerBuilder) {
+	lb.Closers = append(lb.Closers, c.closers...)
+}
+
+// Apply method will set the configured exit timeout to the input pointer to a LoggerBuilder
+func (c *LCExitTimeout) Apply(lb *LoggerBuilder) {
+	lb.ExitTimeout = c.d
+}
+
+// WithExitFunc function will allow creating a LoggerConfig that replaces the os.Exit(1) call made
+// after writing a fatal event, such as to run cleanup code or to test fatal paths. It returns nil
+// if the input function is nil.
+//
+// The function is called after the Logger flushes and closes its outputs, unless the Logger is
+// set to skip exit calls
+func WithExitFunc(fn func(code int)) LoggerConfig {
+	if fn == nil {
+		return nil
+	}
+
+	return &LCExitFunc{
+		fn: fn,
+	}
+}
+
+// WithPanicFunc function will allow creating a LoggerConfig that replaces the panic() call made
+// after writing a panic event, with the event's message as input. It returns nil if the input
+// function is nil.
+//
+// The function is called after the Logger flushes and syncs its outputs, unless the Logger is
+// set to skip exit calls
+func WithPanicFunc(fn func(v interface{})) LoggerConfig {
+	if fn == nil {
+		return nil
+	}
+
+	return &LCPanicFunc{
+		fn: fn,
+	}
+}
+
+// WithCloser function will allow creating a LoggerConfig that registers io.Closers (such as
+// database connections or gRPC clients) in a Logger. It returns nil if no (non-nil) io.Closers
+// are provided.
+//
+// Before exiting or panicking, the Logger flushes its asynchronous queue (if any), syncs its
+// outputs and closes its io.Closers once, waiting up to its exit timeout. Writers set with WithDatabase() are
+// registered automatically
+func WithCloser(closers ...io.Closer) LoggerConfig {
+	var c = make([]io.Closer, 0, len(closers))
+
+	for _, closer := range closers {
+		if closer != nil {
+			c = append(c, closer)
+		}
+	}
+
+	if len(c) == 0 {
+		return nil
+	}
+
+	return &LCCloser{
+		closers: c,
+	}
+}
+
+// WithExitTimeout function will allow creating a LoggerConfig that sets how long a Logger waits
+// for its asynchronous queue to flush and its outputs and io.Closers to close, before exiting or
+// panicking anyway. It returns nil if the input duration is not positive; the default is 5 seconds
+func WithExitTimeout(d time.Duration) LoggerConfig {
+	if d <= 0 {
+		return nil
+	}
+
+	return &LCExitTimeout{
+		d: d,
+	}
+}
+
+// exitContext method returns a context which is done once the root Logger's exit timeout (or the
+// default one) is reached
+func (l *logger) exitContext() (context.Context, context.CancelFunc) {
+	timeout := l.root().exitTimeout
+	if timeout <= 0 {
+		timeout = defaultExitTimeout
+	}
+
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// shutdown method flushes the Logger's asynchronous queue, syncs its outputs and closes its
+// io.Closers (only on the first call), giving up once the exit timeout is reached. Before exiting,
+// the root Logger is closed instead, which also closes its outputs; while a panic (which may be
+// recovered) leaves them open
+func (l *logger) shutdown(exit bool) {
+	root := l.root()
+
+	ctx, cancel := l.exitContext()
+	defer cancel()
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = l.Flush(ctx) // deliberately ignore error in this method call
+
+		if exit {
+			_ = root.Close() // deliberately ignore error in this method call
+			return
+		}
+
+		_ = root.Sync() // deliberately ignore error in this method call
+
+		root.closeOnce.Do(func() {
+			for _, c := range root.closers {
+				_ = c.Close() // deliberately ignore error in this method call
+			}
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+// exitWith method flushes and closes the Logger's outputs, and calls its exit function (or
+// os.Exit) with the input code
+func (l *logger) exitWith(code int) {
+	l.shutdown(true)
+
+	if fn := l.root().exitFn; fn != nil {
+		fn(code)
+		return
+	}
+
+	os.Exit(code)
+}
+
+// panicWith method flushes and syncs the Logger's outputs and closes its io.Closers, and calls
+// its panic function (or panic) with the input value
+func (l *logger) panicWith(v interface{}) {
+	l.shutdown(false)
+
+	if fn := l.root().panicFn; fn != nil {
+		fn(v)
+		return
+	}
+
+	panic(v)
+}
+
+// shutdown method flushes and closes the outputs of all of the multiLogger's Loggers, at the
+// same time. Loggers created with New() are shut down as on their own exit (or panic), while other
+// Loggers are closed, waiting up to the default exit timeout
+func (l *multiLogger) shutdown(exit bool) {
+	var wg sync.WaitGroup
+
+	for _, child := range l.loggers {
+		wg.Add(1)
+
+		go func(child Logger) {
+			defer wg.Done()
+
+			if lg, ok := child.(*logger); ok {
+				lg.shutdown(exit)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), defaultExitTimeout)
+			defer cancel()
+
+			done := make(chan struct{})
+
+			go func() {
+				defer close(done)
+				_ = child.Close() // deliberately ignore error in this method call
+			}()
+
+			select {
+			case <-done:
+			case <-ctx.Done():
+			}
+		}(child)
+	}
+
+	wg.Wait()
+}
+
+// exitWith method flushes and closes the outputs of all of the multiLogger's Loggers, and then
+// exits once, with the exit function of its first Logger which sets one (or os.Exit)
+func (l *multiLogger) exitWith(code int) {
+	l.shutdown(true)
+
+	for _, child := range l.loggers {
+		if lg, ok := child.(*logger); ok && lg.root().exitFn != nil {
+			lg.root().exitFn(code)
+			return
+		}
+	}
+
+	os.Exit(code)
+}
+
+// panicWith method flushes and syncs the outputs of all of the multiLogger's Loggers, and then
+// panics once, with the panic function of its first Logger which sets one (or panic)
+func (l *multiLogger) panicWith(v interface{}) {
+	l.shutdown(false)
+
+	for _, child := range l.loggers {
+		if lg, ok := child.(*logger); ok && lg.root().panicFn != nil {
+			lg.root().panicFn(v)
+			return
+		}
+	}
+
+	panic(v)
+}
@@ -0,0 +1,326 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+type testCloser struct {
+	closed int32
+	delay  time.Duration
+}
+
+func (c *testCloser) Close() error {
+	time.Sleep(c.delay)
+	atomic.AddInt32(&c.closed, 1)
+	return nil
+}
+
+func TestWithExitFunc(t *testing.T) {
+	module := "LoggerConfig"
+	funcname := "WithExitFunc()"
+
+	type test struct {
+		name  string
+		confs []LoggerConfig
+		log   func(l Logger)
+		exits int
+	}
+
+	var tests = []test{
+		{
+			name:  "fatal event",
+			log:   func(l Logger) { l.Fatal("null") },
+			exits: 1,
+		},
+		{
+			name:  "fatal event in Log()",
+			log:   func(l Logger) { l.Log(event.New().Level(event.Level_fatal).Message("null").Build()) },
+			exits: 1,
+		},
+		{
+			name:  "fatal event in an asynchronous logger",
+			confs: []LoggerConfig{WithAsync(16, OverflowBlock)},
+			log:   func(l Logger) { l.Fatalf("%s", "null") },
+			exits: 1,
+		},
+		{
+			name:  "fatal event in a child logger",
+			log:   func(l Logger) { l.With(map[string]interface{}{"a": 1}).Fatalln("null") },
+			exits: 1,
+		},
+		{
+			name:  "skip exit calls",
+			confs: []LoggerConfig{SkipExit},
+			log:   func(l Logger) { l.Fatal("null") },
+			exits: 0,
+		},
+		{
+			name:  "non-fatal event",
+			log:   func(l Logger) { l.Error("null") },
+			exits: 0,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		var (
+			buf    = new(bytes.Buffer)
+			closer = new(testCloser)
+			codes  []int
+			output []string
+		)
+
+		confs := append([]LoggerConfig{
+			WithOut(buf),
+			CfgTextOnly,
+			WithCloser(closer),
+			WithExitFunc(func(code int) {
+				codes = append(codes, code)
+				output = append(output, buf.String())
+			}),
+		}, test.confs...)
+
+		test.log(New(confs...))
+
+		if len(codes) != test.exits {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v exit calls; got %v -- action: %s", idx, module, funcname, test.exits, len(codes), test.name)
+			return
+		}
+
+		if test.exits > 0 {
+			if codes[0] != 1 {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected exit code: %v -- action: %s", idx, module, funcname, codes[0], test.name)
+				return
+			}
+
+			if !strings.Contains(output[0], "null") {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] the event was not written before exiting: %q -- action: %s", idx, module, funcname, output[0], test.name)
+				return
+			}
+		}
+
+		if closed := int(atomic.LoadInt32(&closer.closed)); closed != test.exits {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v closes; got %v -- action: %s", idx, module, funcname, test.exits, closed, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestWithPanicFunc(t *testing.T) {
+	module := "LoggerConfig"
+	funcname := "WithPanicFunc()"
+
+	var values []interface{}
+	closer := new(testCloser)
+
+	logger := New(
+		WithOut(new(bytes.Buffer)),
+		WithCloser(closer),
+		WithPanicFunc(func(v interface{}) {
+			values = append(values, v)
+		}),
+	)
+
+	logger.Panic("first")
+	logger.Panicf("%s", "second")
+
+	if len(values) != 2 || values[0] != "first" || values[1] != "second" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected panic values: %v -- action: %s", 0, module, funcname, values, "replace panic calls")
+		return
+	}
+
+	if closed := atomic.LoadInt32(&closer.closed); closed != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected closers to be closed once; got %v -- action: %s", 1, module, funcname, closed, "close once")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "replace panic calls")
+}
+
+func TestWithCloser(t *testing.T) {
+	module := "LoggerConfig"
+	funcname := "WithCloser()"
+
+	if WithCloser() != nil || WithCloser(nil) != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a nil config -- action: %s", 0, module, funcname, "no closers")
+		return
+	}
+
+	closers := []io.Closer{new(testCloser), new(testCloser)}
+	builder := &LoggerBuilder{}
+
+	WithCloser(closers[0]).Apply(builder)
+	WithCloser(nil, closers[1]).Apply(builder)
+
+	if len(builder.Closers) != 2 || builder.Closers[0] != closers[0] || builder.Closers[1] != closers[1] {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected closers: %v -- action: %s", 1, module, funcname, builder.Closers, "add closers")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "add closers")
+}
+
+func TestWithExitTimeout(t *testing.T) {
+	module := "LoggerConfig"
+	funcname := "WithExitTimeout()"
+
+	if WithExitTimeout(0) != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a nil config -- action: %s", 0, module, funcname, "zero timeout")
+		return
+	}
+
+	var exited bool
+
+	logger := New(
+		WithOut(new(bytes.Buffer)),
+		WithCloser(&testCloser{delay: time.Second}),
+		WithExitTimeout(10*time.Millisecond),
+		WithExitFunc(func(int) { exited = true }),
+	)
+
+	start := time.Now()
+	logger.Fatal("null")
+
+	if !exited || time.Since(start) >= time.Second {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected to exit after the timeout; took %v -- action: %s", 1, module, funcname, time.Since(start), "slow closer")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "slow closer")
+}
+
+func TestMultiLoggerExit(t *testing.T) {
+	module := "MultiLogger"
+	funcname := "Fatal()"
+
+	var (
+		exits   int
+		closers = []*testCloser{{}, {}}
+		bufs    = []*bytes.Buffer{{}, {}}
+	)
+
+	exitFn := func(int) {
+		exits++
+	}
+
+	logger := MultiLogger(
+		New(WithOut(bufs[0]), WithCloser(closers[0]), WithExitFunc(exitFn)),
+		New(WithOut(bufs[1]), WithCloser(closers[1]), WithAsync(16, OverflowBlock)),
+	)
+
+	logger.Fatal("first")
+
+	// the Loggers are closed on exit, so further events are dropped
+	logger.Log(event.New().Level(event.Level_fatal).Message("second").Build())
+
+	if exits != 2 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected to exit once per event; got %v -- action: %s", 0, module, funcname, exits, "exit once")
+		return
+	}
+
+	for idx := range bufs {
+		if out := bufs[idx].String(); !strings.Contains(out, "first") || strings.Contains(out, "second") {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] events were not written to logger #%v: %q -- action: %s", 1, module, funcname, idx, out, "flush all loggers")
+			return
+		}
+
+		if closed := atomic.LoadInt32(&closers[idx].closed); closed != 1 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected logger #%v to be closed once; got %v -- action: %s", 2, module, funcname, idx, closed, "close all loggers")
+			return
+		}
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "exit once")
+}
+
+func TestLoggerExitOutputs(t *testing.T) {
+	module := "Logger"
+	funcname := "Fatal()"
+
+	type test struct {
+		name   string
+		log    func(l Logger)
+		synced int32
+		closed int32
+	}
+
+	var tests = []test{
+		{
+			name:   "sync and close the outputs on exit",
+			log:    func(l Logger) { l.Fatal("null") },
+			synced: 1,
+			closed: 1,
+		},
+		{
+			name:   "sync the outputs on panic",
+			log:    func(l Logger) { l.Panic("null") },
+			synced: 1,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		out := new(testSyncCloser)
+
+		logger := New(
+			WithOut(out),
+			WithExitFunc(func(int) {}),
+			WithPanicFunc(func(interface{}) {}),
+			CfgTextOnly,
+		)
+
+		test.log(logger)
+
+		if synced, closed := atomic.LoadInt32(&out.synced), atomic.LoadInt32(&out.closed); synced != test.synced || closed != test.closed {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v syncs and %v closes; got %v and %v -- action: %s", idx, module, funcname, test.synced, test.closed, synced, closed, test.name)
+			return
+		}
+
+		if !strings.Contains(out.String(), "null") {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] the event was not written: %q -- action: %s", idx, module, funcname, out.String(), test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// the queue is flushed up to the exit timeout
+	out := &testSlowWriter{delay: 200 * time.Millisecond}
+
+	logger := New(
+		WithOut(out),
+		WithAsync(16, OverflowBlock),
+		WithExitTimeout(50*time.Millisecond),
+		WithExitFunc(func(int) {}),
+		CfgTextOnly,
+	)
+
+	for i := 0; i < 8; i++ {
+		logger.Info("queued")
+	}
+
+	start := time.Now()
+	logger.Fatal("null")
+
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected to give up on the queue after the exit timeout; took %v -- action: %s", 2, module, funcname, elapsed, "slow queue")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 2, module, funcname, "slow queue")
+}
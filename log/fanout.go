@@ -0,0 +1,475 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// fanOutLogger struct is a multiLogger which writes to its Loggers in parallel, through a
+// store.FanOut. Its children share the same store.FanOut, which is only closed by its owner
+type fanOutLogger struct {
+	*multiLogger
+
+	fan   *store.FanOut
+	owner bool
+}
+
+// FanOutLogger function is a wrapper for multiple Loggers, similar to MultiLogger(); except that
+// each event is written to all of its Loggers in parallel, with one goroutine and queue per Logger.
+//
+// Each write waits for the Loggers up to the input timeout (or until they are all done, if zero),
+// so that a slow output (like a remote database) adds at most the timeout to each log call instead
+// of its full latency. A Logger that times out keeps the event queued, and a Logger whose queue is
+// full skips it. A failing Logger never prevents the others from writing the event, and the failures
+// are returned by Output() and Write() as a chain of *store.WriteError, identifying the Loggers by
+// index and type:
+//
+//	logger := log.FanOutLogger(100*time.Millisecond,
+//	    log.New(log.WithOut(os.Stderr)),
+//	    log.New(log.WithDatabase(mongoWriter)),
+//	)
+//	defer logger.Close()
+//
+// The Loggers keep their own error handlers (see WithErrorHandler()). Closing the returned Logger
+// waits for the queued events before closing its Loggers
+func FanOutLogger(timeout time.Duration, loggers ...Logger) Logger {
+	ml := new(multiLogger)
+	ml.addLoggers(loggers...)
+
+	switch len(ml.loggers) {
+	case 0:
+		return nil
+	case 1:
+		return ml.loggers[0]
+	}
+
+	return &fanOutLogger{
+		multiLogger: ml,
+		fan:         store.NewFanOut(len(ml.loggers), timeout),
+		owner:       true,
+	}
+}
+
+// errors method converts the input errors (by Logger index) into a chain of *store.WriteError
+func (l *fanOutLogger) errors(errs []error) error {
+	var werrs []*store.WriteError
+
+	for idx, err := range errs {
+		if err != nil {
+			werrs = append(werrs, store.NewWriteError(idx, l.loggers[idx], err))
+		}
+	}
+
+	return store.JoinWriteErrors(werrs...)
+}
+
+// Output method writes a copy of the input event.Event to each of the fanOutLogger's Loggers, in
+// parallel. It returns the number of bytes written by the first Logger that writes any
+func (l *fanOutLogger) Output(m *event.Event) (n int, err error) {
+	var (
+		events = make([]*event.Event, len(l.loggers))
+		ns     = make([]int64, len(l.loggers))
+	)
+
+	// the Loggers apply their own defaults to the event, so each one gets its own copy; with the
+	// caller captured here, as the Loggers' goroutines no longer hold the caller's frames
+	for idx := range events {
+		events[idx] = proto.Clone(m).(*event.Event)
+
+		if m.Caller == nil {
+			events[idx].Caller = callerOf(l.loggers[idx])
+		}
+	}
+
+	errs := l.fan.Do(func(idx int) error {
+		n, err := l.loggers[idx].Output(events[idx])
+		atomic.StoreInt64(&ns[idx], int64(n))
+
+		return err
+	})
+
+	for idx := range ns {
+		if n = int(atomic.LoadInt64(&ns[idx])); n != 0 {
+			break
+		}
+	}
+
+	return n, l.errors(errs)
+}
+
+// callerOf function captures the caller for the input Logger, if it is set to add caller information
+// to its events; as per its own skip list. It must be called from the goroutine issuing the log call
+func callerOf(l Logger) *event.Caller {
+	switch logger := l.(type) {
+	case *logger:
+		if logger.caller {
+			return logger.callerFrame()
+		}
+	case *fanOutLogger:
+		for _, inner := range logger.loggers {
+			if c := callerOf(inner); c != nil {
+				return c
+			}
+		}
+	}
+
+	return nil
+}
+
+// Write method writes a copy of the input buffer to each of the fanOutLogger's Loggers, in
+// parallel. It returns the length of the input buffer, or zero alongside the Loggers' errors
+func (l *fanOutLogger) Write(p []byte) (n int, err error) {
+	buf := make([]byte, len(p))
+	copy(buf, p)
+
+	errs := l.fan.Do(func(idx int) error {
+		n, err := l.loggers[idx].Write(buf)
+
+		if err == nil && n == 0 {
+			return io.ErrShortWrite
+		}
+
+		return err
+	})
+
+	if err = l.errors(errs); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Log method writes the input event.Events to all of the fanOutLogger's Loggers, in parallel.
+// Fatal and panic events wait for the queued events, and flush and close all Loggers before
+// exiting or panicking (once)
+func (l *fanOutLogger) Log(m ...*event.Event) {
+	for _, msg := range m {
+		if msg == nil {
+			continue
+		}
+
+		s := msg.GetMsg()
+
+		_, _ = l.Output(msg) // deliberately ignore error in this method call
+
+		if !l.IsSkipExit() && msg.GetLevel() == event.Level_panic {
+			l.panicWith(s)
+		} else if !l.IsSkipExit() && msg.GetLevel() == event.Level_fatal {
+			l.exitWith(1)
+		}
+	}
+}
+
+// print method builds an event.Event with the input level, message and error, and writes it to
+// all of the fanOutLogger's Loggers (which apply their own prefix and metadata to it)
+func (l *fanOutLogger) print(level event.Level, msg string, err error) {
+	_, _ = l.Output(newEvent(level, msg, err)) // deliberately ignore error in this method call
+}
+
+// newEvent function builds an event.Event with the input level, message and error (if not nil)
+func newEvent(level event.Level, msg string, err error) *event.Event {
+	b := event.New().Level(level).Message(msg)
+
+	if err != nil {
+		b = b.Err(err)
+	}
+
+	return b.Build()
+}
+
+// exitWith method waits for the queued events (up to the default exit timeout), and then exits
+// as a multiLogger does
+func (l *fanOutLogger) exitWith(code int) {
+	l.flushExit()
+	l.multiLogger.exitWith(code)
+}
+
+// panicWith method waits for the queued events (up to the default exit timeout), and then panics
+// as a multiLogger does
+func (l *fanOutLogger) panicWith(v interface{}) {
+	l.flushExit()
+	l.multiLogger.panicWith(v)
+}
+
+// flushExit method waits for the queued events, up to the default exit timeout
+func (l *fanOutLogger) flushExit() {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultExitTimeout)
+	defer cancel()
+
+	_ = l.fan.Flush(ctx) // deliberately ignore error in this method call
+}
+
+// Flush method waits for the queued events, and then flushes all of the fanOutLogger's
+// asynchronous Loggers; or returns the input context's error once it is done
+func (l *fanOutLogger) Flush(ctx context.Context) error {
+	if err := l.fan.Flush(ctx); err != nil {
+		return err
+	}
+
+	return l.multiLogger.Flush(ctx)
+}
+
+// Sync method waits for the queued events, and then syncs all of the fanOutLogger's Loggers
+func (l *fanOutLogger) Sync() error {
+	_ = l.fan.Flush(context.Background()) // deliberately ignore error in this method call
+
+	return l.multiLogger.Sync()
+}
+
+// Close method waits for the queued events, and then closes all of the fanOutLogger's Loggers.
+// Only the Logger returned by FanOutLogger() stops the goroutines, as they are shared with its
+// children
+func (l *fanOutLogger) Close() error {
+	if l.owner {
+		l.fan.Close()
+	} else {
+		_ = l.fan.Flush(context.Background()) // deliberately ignore error in this method call
+	}
+
+	return l.multiLogger.Close()
+}
+
+// Child method returns a fanOutLogger of the Loggers' children, which shares the same goroutines
+func (l *fanOutLogger) Child(prefix, sub string, fields map[string]interface{}) Logger {
+	loggers := make([]Logger, 0, len(l.loggers))
+
+	for _, logger := range l.loggers {
+		loggers = append(loggers, logger.Child(prefix, sub, fields))
+	}
+
+	return &fanOutLogger{
+		multiLogger: &multiLogger{loggers: loggers},
+		fan:         l.fan,
+	}
+}
+
+// With method returns a fanOutLogger of the Loggers' children, with the input metadata fields
+func (l *fanOutLogger) With(fields map[string]interface{}) Logger {
+	return l.Child("", "", fields)
+}
+
+// SetOuts method is similar to a Logger.SetOuts() method, however the fanOutLogger will
+// range through all of its configured loggers and execute the same SetOuts() method call
+// on each of them, as a multiLogger does
+func (l *fanOutLogger) SetOuts(outs ...io.Writer) Logger {
+	l.multiLogger.SetOuts(outs...)
+	return l
+}
+
+// AddOuts method is similar to a Logger.AddOuts() method, however the fanOutLogger will
+// range through all of its configured loggers and execute the same AddOuts() method call
+// on each of them, as a multiLogger does
+func (l *fanOutLogger) AddOuts(outs ...io.Writer) Logger {
+	l.multiLogger.AddOuts(outs...)
+	return l
+}
+
+// Prefix method is similar to a Logger.Prefix() method, however the fanOutLogger will
+// range through all of its configured loggers and execute the same Prefix() method call
+// on each of them -- applying the input prefix string as each Logger's prefix.
+func (l *fanOutLogger) Prefix(prefix string) Logger {
+	l.multiLogger.Prefix(prefix)
+	return l
+}
+
+// Sub method is similar to a Logger.Sub() method, however the fanOutLogger will
+// range through all of its configured loggers and execute the same Sub() method call
+// on each of them -- applying the input sub-prefix string as each Logger's sub-prefix.
+func (l *fanOutLogger) Sub(sub string) Logger {
+	l.multiLogger.Sub(sub)
+	return l
+}
+
+// Fields method is similar to a Logger.Fields() method, however the fanOutLogger will
+// range through all of its configured loggers and execute the same Fields() method call
+// on each of them -- applying the input Metadata map as the Logger's metadata.
+func (l *fanOutLogger) Fields(fields map[string]interface{}) Logger {
+	l.multiLogger.Fields(fields)
+	return l
+}
+
+// Print method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, in parallel
+//
+// It applies LogLevel Info
+func (l *fanOutLogger) Print(v ...interface{}) {
+	l.print(event.Level_info, fmt.Sprint(v...), nil)
+}
+
+// Println method (similar to fmt.Println) will print a message using an fmt.Sprintln(v...) pattern
+// across all configured Loggers, in parallel
+//
+// It applies LogLevel Info
+func (l *fanOutLogger) Println(v ...interface{}) {
+	l.print(event.Level_info, fmt.Sprintln(v...), nil)
+}
+
+// Printf method (similar to fmt.Printf) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, in parallel
+//
+// It applies LogLevel Info
+func (l *fanOutLogger) Printf(format string, v ...interface{}) {
+	l.print(event.Level_info, fmt.Sprintf(format, v...), nil)
+}
+
+// Panic method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Panic.
+//
+// This method will end calling `panic()` with the event.Event's message content, if the logger is not set to
+// skip exit calls.
+func (l *fanOutLogger) Panic(v ...interface{}) {
+	l.Log(newEvent(event.Level_panic, fmt.Sprint(v...), nil))
+}
+
+// Panicln method (similar to fmt.Print) will print a message using an fmt.Sprintln(v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Panic.
+//
+// This method will end calling `panic()` with the event.Event's message content, if the logger is not set to
+// skip exit calls.
+func (l *fanOutLogger) Panicln(v ...interface{}) {
+	l.Log(newEvent(event.Level_panic, fmt.Sprintln(v...), nil))
+}
+
+// Panicf method (similar to fmt.Print) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Panic.
+//
+// This method will end calling `panic()` with the event.Event's message content, if the logger is not set to
+// skip exit calls.
+func (l *fanOutLogger) Panicf(format string, v ...interface{}) {
+	l.Log(newEvent(event.Level_panic, fmt.Sprintf(format, v...), nil))
+}
+
+// Fatal method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Fatal.
+//
+// This method will end calling `os.Exit(1)`, if the logger is not set to skip exit calls.
+func (l *fanOutLogger) Fatal(v ...interface{}) {
+	l.Log(newEvent(event.Level_fatal, fmt.Sprint(v...), nil))
+}
+
+// Fatalln method (similar to fmt.Print) will print a message using an fmt.Sprintln(v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Fatal.
+//
+// This method will end calling `os.Exit(1)`, if the logger is not set to skip exit calls.
+func (l *fanOutLogger) Fatalln(v ...interface{}) {
+	l.Log(newEvent(event.Level_fatal, fmt.Sprintln(v...), nil))
+}
+
+// Fatalf method (similar to fmt.Print) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Fatal.
+//
+// This method will end calling `os.Exit(1)`, if the logger is not set to skip exit calls.
+func (l *fanOutLogger) Fatalf(format string, v ...interface{}) {
+	l.Log(newEvent(event.Level_fatal, fmt.Sprintf(format, v...), nil))
+}
+
+// Error method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Error.
+func (l *fanOutLogger) Error(v ...interface{}) {
+	l.print(event.Level_error, fmt.Sprint(v...), nil)
+}
+
+// Errorln method (similar to fmt.Print) will print a message using an fmt.Sprintln(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Error.
+func (l *fanOutLogger) Errorln(v ...interface{}) {
+	l.print(event.Level_error, fmt.Sprintln(v...), nil)
+}
+
+// Errorf method (similar to fmt.Print) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Error.
+func (l *fanOutLogger) Errorf(format string, v ...interface{}) {
+	l.print(event.Level_error, fmt.Sprintf(format, v...), nil)
+}
+
+// Err method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Error and
+// recording the input error in the event.
+func (l *fanOutLogger) Err(err error, v ...interface{}) {
+	l.print(event.Level_error, fmt.Sprint(v...), err)
+}
+
+// Errf method (similar to fmt.Printf) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Error and
+// recording the input error in the event.
+func (l *fanOutLogger) Errf(err error, format string, v ...interface{}) {
+	l.print(event.Level_error, fmt.Sprintf(format, v...), err)
+}
+
+// Warn method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Warn.
+func (l *fanOutLogger) Warn(v ...interface{}) {
+	l.print(event.Level_warn, fmt.Sprint(v...), nil)
+}
+
+// Warnln method (similar to fmt.Print) will print a message using an fmt.Sprintln(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Warn.
+func (l *fanOutLogger) Warnln(v ...interface{}) {
+	l.print(event.Level_warn, fmt.Sprintln(v...), nil)
+}
+
+// Warnf method (similar to fmt.Print) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Warn.
+func (l *fanOutLogger) Warnf(format string, v ...interface{}) {
+	l.print(event.Level_warn, fmt.Sprintf(format, v...), nil)
+}
+
+// Info method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Info.
+func (l *fanOutLogger) Info(v ...interface{}) {
+	l.print(event.Level_info, fmt.Sprint(v...), nil)
+}
+
+// Infoln method (similar to fmt.Print) will print a message using an fmt.Sprintln(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Info.
+func (l *fanOutLogger) Infoln(v ...interface{}) {
+	l.print(event.Level_info, fmt.Sprintln(v...), nil)
+}
+
+// Infof method (similar to fmt.Print) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Info.
+func (l *fanOutLogger) Infof(format string, v ...interface{}) {
+	l.print(event.Level_info, fmt.Sprintf(format, v...), nil)
+}
+
+// Debug method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Debug.
+func (l *fanOutLogger) Debug(v ...interface{}) {
+	l.print(event.Level_debug, fmt.Sprint(v...), nil)
+}
+
+// Debugln method (similar to fmt.Print) will print a message using an fmt.Sprintln(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Debug.
+func (l *fanOutLogger) Debugln(v ...interface{}) {
+	l.print(event.Level_debug, fmt.Sprintln(v...), nil)
+}
+
+// Debugf method (similar to fmt.Print) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Debug.
+func (l *fanOutLogger) Debugf(format string, v ...interface{}) {
+	l.print(event.Level_debug, fmt.Sprintf(format, v...), nil)
+}
+
+// Trace method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Trace.
+func (l *fanOutLogger) Trace(v ...interface{}) {
+	l.print(event.Level_trace, fmt.Sprint(v...), nil)
+}
+
+// Traceln method (similar to fmt.Print) will print a message using an fmt.Sprintln(v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Trace.
+func (l *fanOutLogger) Traceln(v ...interface{}) {
+	l.print(event.Level_trace, fmt.Sprintln(v...), nil)
+}
+
+// Tracef method (similar to fmt.Print) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, in parallel, while automatically applying LogLevel Trace.
+func (l *fanOutLogger) Tracef(format string, v ...interface{}) {
+	l.print(event.Level_trace, fmt.Sprintf(format, v...), nil)
+}
@@ -0,0 +1,229 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/store"
+)
+
+type testSlowWriter struct {
+	mu    sync.Mutex
+	buf   bytes.Buffer
+	delay time.Duration
+}
+
+func (w *testSlowWriter) Write(p []byte) (n int, err error) {
+	time.Sleep(w.delay)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.buf.Write(p)
+}
+
+func (w *testSlowWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.buf.String()
+}
+
+func TestFanOutLogger(t *testing.T) {
+	module := "FanOutLogger"
+	funcname := "Output()"
+
+	type test struct {
+		name string
+		log  func(l Logger) error
+		msgs []string
+	}
+
+	var tests = []test{
+		{
+			name: "Output() call",
+			log: func(l Logger) error {
+				_, err := l.Output(event.New().Message("null").Build())
+				return err
+			},
+			msgs: []string{"null"},
+		},
+		{
+			name: "Print() calls",
+			log: func(l Logger) error {
+				l.Info("first")
+				l.Warnf("%s", "second")
+				l.Err(errors.New("third"), "fourth")
+				return nil
+			},
+			msgs: []string{"first", "second", "third", "fourth"},
+		},
+		{
+			name: "child logger",
+			log: func(l Logger) error {
+				l.With(map[string]interface{}{"a": 1}).Info("null")
+				return nil
+			},
+			msgs: []string{"null", "a = 1"},
+		},
+		{
+			name: "Write() call",
+			log: func(l Logger) error {
+				_, err := l.Write([]byte("null"))
+				return err
+			},
+			msgs: []string{"null"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		outs := []*testSlowWriter{{delay: 30 * time.Millisecond}, {delay: 30 * time.Millisecond}, {delay: 30 * time.Millisecond}}
+
+		logger := FanOutLogger(time.Second,
+			New(WithOut(outs[0]), WithPrefix("zero"), CfgFormatText),
+			New(WithOut(outs[1]), WithPrefix("one"), CfgFormatText),
+			New(WithOut(outs[2]), WithPrefix("two"), CfgFormatText),
+		)
+		defer logger.Close()
+
+		start := time.Now()
+
+		if err := test.log(logger); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		if elapsed, limit := time.Since(start), time.Duration(len(test.msgs)+1)*60*time.Millisecond; elapsed > limit {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected the loggers to be written in parallel; took %v -- action: %s", idx, module, funcname, elapsed, test.name)
+			return
+		}
+
+		for i, out := range outs {
+			for _, msg := range test.msgs {
+				if !strings.Contains(out.String(), msg) {
+					t.Errorf("#%v -- FAILED -- [%s] [%s] logger #%v is missing %q: %q -- action: %s", idx, module, funcname, i, msg, out.String(), test.name)
+					return
+				}
+			}
+		}
+
+		if test.name != "Write() call" && !strings.Contains(outs[1].String(), "[one]") {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected the logger's own prefix: %q -- action: %s", idx, module, funcname, outs[1].String(), test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFanOutLoggerErrors(t *testing.T) {
+	module := "FanOutLogger"
+	funcname := "Output()"
+
+	var (
+		handler = new(testErrors)
+		slow    = &testSlowWriter{delay: time.Second}
+		buf     = new(testSlowWriter)
+	)
+
+	logger := FanOutLogger(50*time.Millisecond,
+		New(WithOut(testFailingWriter{}), CfgTextOnly, WithErrorHandler(handler.handle)),
+		New(WithOut(slow), CfgTextOnly),
+		New(WithOut(buf), CfgTextOnly),
+	)
+
+	start := time.Now()
+	_, err := logger.Output(event.New().Message("null").Build())
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the slow logger to time out; took %v -- action: %s", 0, module, funcname, elapsed, "isolate failures")
+		return
+	}
+
+	var werr *store.WriteError
+
+	if !errors.As(err, &werr) || werr.Index != 0 || werr.Type != "*log.logger" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected first error: %v -- action: %s", 0, module, funcname, err, "isolate failures")
+		return
+	}
+
+	if !errors.As(errors.Unwrap(err), &werr) || werr.Index != 1 || !errors.Is(werr, store.ErrTimeout) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected second error: %v -- action: %s", 0, module, funcname, err, "isolate failures")
+		return
+	}
+
+	if !strings.Contains(buf.String(), "null") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the remaining logger to write the event -- action: %s", 0, module, funcname, "isolate failures")
+		return
+	}
+
+	handler.mu.Lock()
+	handled := len(handler.errs)
+	handler.mu.Unlock()
+
+	if handled != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the failing logger's error handler to be called once; got %v -- action: %s", 0, module, funcname, handled, "isolate failures")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "isolate failures")
+
+	if n, err := logger.Write([]byte("null")); n != 0 || !errors.As(err, &werr) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected no bytes written and a WriteError; got %v, %v -- action: %s", 2, module, funcname, n, err, "failed write")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 2, module, funcname, "failed write")
+
+	if err := logger.Close(); err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 1, module, funcname, err, "close the loggers")
+		return
+	}
+
+	if !strings.Contains(slow.String(), "null") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the queued event to be written before closing -- action: %s", 1, module, funcname, "close the loggers")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "close the loggers")
+}
+
+func TestFanOutLoggerExit(t *testing.T) {
+	module := "FanOutLogger"
+	funcname := "Fatal()"
+
+	var (
+		exits int
+		outs  = []*testSlowWriter{{delay: 100 * time.Millisecond}, {}}
+	)
+
+	logger := FanOutLogger(10*time.Millisecond,
+		New(WithOut(outs[0]), CfgTextOnly, WithExitFunc(func(int) { exits++ })),
+		New(WithOut(outs[1]), CfgTextOnly),
+	)
+
+	logger.Fatal("null")
+
+	if exits != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected to exit once; got %v -- action: %s", 0, module, funcname, exits, "exit once")
+		return
+	}
+
+	for idx, out := range outs {
+		if !strings.Contains(out.String(), "null") {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] logger #%v did not write the event before exiting -- action: %s", 0, module, funcname, idx, "exit once")
+			return
+		}
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "exit once")
+}
@@ -1,6 +1,7 @@
 package bson
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/zalgonoise/zlog/log/event"
@@ -19,6 +20,179 @@ type entry struct {
 	Level  string                 `bson:"level,omitempty"`
 	Msg    string                 `bson:"message,omitempty"`
 	Meta   map[string]interface{} `bson:"metadata,omitempty"`
+	Caller *caller                `bson:"caller,omitempty"`
+	Error  *errEntry              `bson:"error,omitempty"`
+	Data   map[string]value       `bson:"data,omitempty"`
+}
+
+// value struct holds an event.Value in BSON; as BSON has no unsigned 64-bit integers and its
+// datetime only has millisecond precision, these are stored as a decimal string and as
+// seconds and nanoseconds, respectively. The values in nested maps are held in Map. A value with
+// no fields set is an empty byte slice
+type value struct {
+	Int      *int64           `bson:"int,omitempty"`
+	Uint     *string          `bson:"uint,omitempty"`
+	Bytes    []byte           `bson:"bytes,omitempty"`
+	Time     *timestamp       `bson:"time,omitempty"`
+	Duration *int64           `bson:"duration,omitempty"`
+	Map      map[string]value `bson:"map,omitempty"`
+}
+
+type timestamp struct {
+	Seconds int64 `bson:"seconds"`
+	Nanos   int32 `bson:"nanos"`
+}
+
+func newData(data map[string]*event.Value) map[string]value {
+	if len(data) == 0 {
+		return nil
+	}
+
+	out := make(map[string]value, len(data))
+
+	for k, v := range data {
+		var val value
+
+		if m := v.GetMapValue(); m != nil {
+			if val.Map = newData(m.GetValues()); val.Map != nil {
+				out[k] = val
+			}
+
+			continue
+		}
+
+		switch t := v.Interface().(type) {
+		case int64:
+			val.Int = &t
+		case uint64:
+			u := strconv.FormatUint(t, 10)
+			val.Uint = &u
+		case []byte:
+			val.Bytes = t
+		case time.Time:
+			val.Time = &timestamp{Seconds: t.Unix(), Nanos: int32(t.Nanosecond())}
+		case time.Duration:
+			d := int64(t)
+			val.Duration = &d
+		default:
+			continue
+		}
+
+		out[k] = val
+	}
+
+	return out
+}
+
+func decodeData(data map[string]value) (map[string]*event.Value, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	out := make(map[string]*event.Value, len(data))
+
+	for k, v := range data {
+		var raw interface{}
+
+		if v.Map != nil {
+			values, err := decodeData(v.Map)
+			if err != nil {
+				return nil, err
+			}
+
+			out[k], _ = event.NewMapValue(values)
+			continue
+		}
+
+		switch {
+		case v.Int != nil:
+			raw = *v.Int
+		case v.Uint != nil:
+			u, err := strconv.ParseUint(*v.Uint, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			raw = u
+		case v.Time != nil:
+			raw = time.Unix(v.Time.Seconds, int64(v.Time.Nanos)).UTC()
+		case v.Duration != nil:
+			raw = time.Duration(*v.Duration)
+		default:
+			raw = v.Bytes
+			if v.Bytes == nil {
+				raw = []byte{}
+			}
+		}
+
+		out[k], _ = event.NewValue(raw)
+	}
+
+	return out, nil
+}
+
+type caller struct {
+	File     string `bson:"file,omitempty"`
+	Line     int32  `bson:"line,omitempty"`
+	Function string `bson:"function,omitempty"`
+}
+
+type errEntry struct {
+	Message string  `bson:"message,omitempty"`
+	Type    string  `bson:"type,omitempty"`
+	Chain   []cause `bson:"chain,omitempty"`
+	Stack   string  `bson:"stack,omitempty"`
+}
+
+type cause struct {
+	Message string `bson:"message,omitempty"`
+	Type    string `bson:"type,omitempty"`
+}
+
+func newError(e *event.Error) *errEntry {
+	if e == nil {
+		return nil
+	}
+
+	out := &errEntry{
+		Message: e.GetMessage(),
+		Type:    e.GetType(),
+		Stack:   e.GetStack(),
+	}
+
+	for _, c := range e.GetChain() {
+		out.Chain = append(out.Chain, cause{
+			Message: c.GetMessage(),
+			Type:    c.GetType(),
+		})
+	}
+
+	return out
+}
+
+func (e *errEntry) decode() *event.Error {
+	if e == nil {
+		return nil
+	}
+
+	var chain []*event.ErrorCause
+
+	for _, c := range e.Chain {
+		chain = append(chain, event.NewErrorCause(c.Message, c.Type))
+	}
+
+	return event.NewErrorFrom(e.Message, e.Type, e.Stack, chain...)
+}
+
+func newCaller(c *event.Caller) *caller {
+	if c == nil {
+		return nil
+	}
+
+	return &caller{
+		File:     c.GetFile(),
+		Line:     c.GetLine(),
+		Function: c.GetFunction(),
+	}
 }
 
 // Format method will take in a pointer to an event.Event; and returns a buffer and an error.
@@ -37,6 +211,9 @@ func (f *FmtBSON) Format(log *event.Event) (buf []byte, err error) {
 		Level:  log.GetLevel().String(),
 		Msg:    log.GetMsg(),
 		Meta:   log.Meta.AsMap(),
+		Caller: newCaller(log.GetCaller()),
+		Error:  newError(log.GetError()),
+		Data:   newData(log.GetData()),
 	})
 }
 
@@ -57,6 +234,17 @@ func Decode(b []byte) (*event.Event, error) {
 		Build()
 
 	e.Time = timestamppb.New(ent.Time)
+
+	if ent.Caller != nil {
+		e.Caller = event.NewCallerFrom(ent.Caller.File, int(ent.Caller.Line), ent.Caller.Function)
+	}
+
+	e.Error = ent.Error.decode()
+
+	if e.Data, err = decodeData(ent.Data); err != nil {
+		return nil, err
+	}
+
 	return e, nil
 
 }
@@ -1,8 +1,12 @@
 package bson
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/zalgonoise/zlog/log/event"
 )
@@ -29,6 +33,26 @@ func TestFormat(t *testing.T) {
 			name: "complete event",
 			e:    event.New().Prefix("test").Sub("testing").Level(event.Level_warn).Message("null").Metadata(event.Field{"a": true}).Build(),
 		},
+		{
+			name: "event with caller",
+			e:    event.New().Prefix("test").Level(event.Level_debug).Message("null").Caller(0).Build(),
+		},
+		{
+			name: "event with lossless metadata",
+			e: event.New().Message("null").Metadata(event.Field{
+				"int":      int64(1<<62 + 1),
+				"uint":     uint64(math.MaxUint64),
+				"time":     time.Unix(1660298400, 123456789).UTC(),
+				"duration": 1500 * time.Nanosecond,
+				"bytes":    []byte("null"),
+				"a":        true,
+				"nested":   map[string]interface{}{"int": int64(1<<62 + 1), "b": "c"},
+			}).Build(),
+		},
+		{
+			name: "event with error",
+			e:    event.New().Prefix("test").Level(event.Level_error).Message("null").Err(fmt.Errorf("wrap: %w", errors.New("inner"))).Build(),
+		},
 	}
 
 	var f = new(FmtBSON)
@@ -136,6 +160,11 @@ func TestFormat(t *testing.T) {
 			)
 			return
 		}
+
+		if testData, data := test.e.Metadata(), e.Metadata(); !reflect.DeepEqual(data, testData) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] -- typed metadata mismatch error: wanted %v ; got %v -- action: %s", idx, module, funcname, testData, data, test.name)
+			return
+		}
 	}
 
 	for idx, test := range tests {
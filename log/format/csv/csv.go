@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/zalgonoise/zlog/log/event"
 	"github.com/zalgonoise/zlog/log/format/text"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,7 +22,7 @@ type FmtCSV struct {
 	JsonMeta bool
 }
 
-type entry [6]string
+type entry [8]string
 
 // FmtCSVBuilder struct allows creating custom CSV Formatters. Its default values will leave
 // its supported options set as false, so it's not required to always use this struct.
@@ -92,8 +94,24 @@ func (f *FmtCSV) Format(log *event.Event) (buf []byte, err error) {
 		m = txt.FmtMetadata(log.Meta.AsMap())
 	}
 
+	// prepare caller value, as `file:line`
+	var c string
+	if log.GetCaller() != nil {
+		c = log.GetCaller().GetFile() + ":" + strconv.Itoa(int(log.GetCaller().GetLine()))
+	}
+
+	// prepare error value, as JSON
+	var er string
+	if log.GetError() != nil {
+		b, err := protojson.Marshal(log.GetError())
+		if err != nil {
+			return nil, err
+		}
+		er = string(b)
+	}
+
 	// default format for:
-	// "timestamp","level","prefix","sub","message","metadata"
+	// "timestamp","level","prefix","sub","message","metadata","caller","error"
 	record := entry{
 		t,
 		log.GetLevel().String(),
@@ -101,6 +119,8 @@ func (f *FmtCSV) Format(log *event.Event) (buf []byte, err error) {
 		log.GetSub(),
 		log.GetMsg(),
 		m,
+		c,
+		er,
 	}
 
 	if err = w.Write(record[:]); err != nil {
@@ -145,9 +165,37 @@ func Decode(buf []byte) (e *event.Event, err error) {
 
 	e.Time = timestamppb.New(timestamp)
 
+	if len(record) > 6 && record[6] != "" {
+		e.Caller = convCaller(record[6])
+	}
+
+	if len(record) > 7 && record[7] != "" {
+		e.Error = new(event.Error)
+
+		if perr := protojson.Unmarshal([]byte(record[7]), e.Error); perr != nil {
+			return nil, perr
+		}
+	}
+
 	return e, err
 }
 
+func convCaller(in string) *event.Caller {
+	idx := strings.LastIndex(in, ":")
+
+	if idx < 0 {
+		return event.NewCallerFrom(in, 0, "")
+	}
+
+	line, err := strconv.Atoi(in[idx+1:])
+
+	if err != nil {
+		return event.NewCallerFrom(in, 0, "")
+	}
+
+	return event.NewCallerFrom(in[:idx], line, "")
+}
+
 func convTime(in string) (out time.Time, err error) {
 	rfcTime, rErr := convRFC3339(in)
 
@@ -1,10 +1,13 @@
 package csv
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/zalgonoise/zlog/log/event"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestNew(t *testing.T) {
@@ -206,3 +209,54 @@ func TestFormat(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestFormatCaller(t *testing.T) {
+	module := "FmtCSV"
+	funcname := "Format()"
+
+	e := event.New().Message("null").Metadata(event.Field{"a": true}).Caller(0).Build()
+
+	b, err := New().JSON().Build().Format(e)
+
+	if err != nil {
+		t.Errorf("[%s] [%s] formatting error: %v", module, funcname, err)
+		return
+	}
+
+	new, err := Decode(b)
+
+	if err != nil {
+		t.Errorf("[%s] [%s] decoding error: %v", module, funcname, err)
+		return
+	}
+
+	// the CSV caller column only holds the file and line
+	if new.GetCaller().GetFile() != e.GetCaller().GetFile() || new.GetCaller().GetLine() != e.GetCaller().GetLine() {
+		t.Errorf("[%s] [%s] caller mismatch: wanted %v ; got %v", module, funcname, e.GetCaller(), new.GetCaller())
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	module := "FmtCSV"
+	funcname := "Format()"
+
+	e := event.New().Message("null").Err(fmt.Errorf("wrap: %w", errors.New("inner"))).Build()
+
+	b, err := New().JSON().Build().Format(e)
+
+	if err != nil {
+		t.Errorf("[%s] [%s] formatting error: %v", module, funcname, err)
+		return
+	}
+
+	new, err := Decode(b)
+
+	if err != nil {
+		t.Errorf("[%s] [%s] decoding error: %v", module, funcname, err)
+		return
+	}
+
+	if !proto.Equal(new.GetError(), e.GetError()) {
+		t.Errorf("[%s] [%s] error mismatch: wanted %v ; got %v", module, funcname, e.GetError(), new.GetError())
+	}
+}
@@ -19,6 +19,73 @@ type entry struct {
 	Level  string
 	Msg    string
 	Meta   map[string]interface{}
+	Caller *caller
+	Error  *errEntry
+}
+
+type caller struct {
+	File     string
+	Line     int32
+	Function string
+}
+
+type errEntry struct {
+	Message string
+	Type    string
+	Chain   []cause
+	Stack   string
+}
+
+type cause struct {
+	Message string
+	Type    string
+}
+
+func newError(e *event.Error) *errEntry {
+	if e == nil {
+		return nil
+	}
+
+	out := &errEntry{
+		Message: e.GetMessage(),
+		Type:    e.GetType(),
+		Stack:   e.GetStack(),
+	}
+
+	for _, c := range e.GetChain() {
+		out.Chain = append(out.Chain, cause{
+			Message: c.GetMessage(),
+			Type:    c.GetType(),
+		})
+	}
+
+	return out
+}
+
+func (e *errEntry) decode() *event.Error {
+	if e == nil {
+		return nil
+	}
+
+	var chain []*event.ErrorCause
+
+	for _, c := range e.Chain {
+		chain = append(chain, event.NewErrorCause(c.Message, c.Type))
+	}
+
+	return event.NewErrorFrom(e.Message, e.Type, e.Stack, chain...)
+}
+
+func newCaller(c *event.Caller) *caller {
+	if c == nil {
+		return nil
+	}
+
+	return &caller{
+		File:     c.GetFile(),
+		Line:     c.GetLine(),
+		Function: c.GetFunction(),
+	}
 }
 
 func Decode(b []byte) (*event.Event, error) {
@@ -44,6 +111,12 @@ func Decode(b []byte) (*event.Event, error) {
 
 	log.Time = timestamppb.New(e.Time)
 
+	if e.Caller != nil {
+		log.Caller = event.NewCallerFrom(e.Caller.File, int(e.Caller.Line), e.Caller.Function)
+	}
+
+	log.Error = e.Error.decode()
+
 	return log, nil
 
 }
@@ -59,6 +132,8 @@ func (f *FmtGob) Format(log *event.Event) ([]byte, error) {
 	gob.Register(map[string]interface{}{})
 	gob.Register([]interface{}{})
 	gob.Register([]map[string]interface{}{})
+	gob.Register(time.Time{})
+	gob.Register(time.Duration(0))
 
 	e := entry{
 		Time:   log.GetTime().AsTime(),
@@ -66,7 +141,9 @@ func (f *FmtGob) Format(log *event.Event) ([]byte, error) {
 		Sub:    log.GetSub(),
 		Level:  log.GetLevel().String(),
 		Msg:    log.GetMsg(),
-		Meta:   log.Meta.AsMap(),
+		Meta:   log.Metadata(), // keeps the event's typed data as native values
+		Caller: newCaller(log.GetCaller()),
+		Error:  newError(log.GetError()),
 	}
 
 	enc := gob.NewEncoder(buf)
@@ -22,6 +22,73 @@ type entry struct {
 	Level  string                 `json:"level,omitempty"`
 	Msg    string                 `json:"message,omitempty"`
 	Meta   map[string]interface{} `json:"metadata,omitempty"`
+	Caller *caller                `json:"caller,omitempty"`
+	Error  *errEntry              `json:"error,omitempty"`
+}
+
+type caller struct {
+	File     string `json:"file,omitempty"`
+	Line     int32  `json:"line,omitempty"`
+	Function string `json:"function,omitempty"`
+}
+
+type errEntry struct {
+	Message string  `json:"message,omitempty"`
+	Type    string  `json:"type,omitempty"`
+	Chain   []cause `json:"chain,omitempty"`
+	Stack   string  `json:"stack,omitempty"`
+}
+
+type cause struct {
+	Message string `json:"message,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+func newError(e *event.Error) *errEntry {
+	if e == nil {
+		return nil
+	}
+
+	out := &errEntry{
+		Message: e.GetMessage(),
+		Type:    e.GetType(),
+		Stack:   e.GetStack(),
+	}
+
+	for _, c := range e.GetChain() {
+		out.Chain = append(out.Chain, cause{
+			Message: c.GetMessage(),
+			Type:    c.GetType(),
+		})
+	}
+
+	return out
+}
+
+func (e *errEntry) decode() *event.Error {
+	if e == nil {
+		return nil
+	}
+
+	var chain []*event.ErrorCause
+
+	for _, c := range e.Chain {
+		chain = append(chain, event.NewErrorCause(c.Message, c.Type))
+	}
+
+	return event.NewErrorFrom(e.Message, e.Type, e.Stack, chain...)
+}
+
+func newCaller(c *event.Caller) *caller {
+	if c == nil {
+		return nil
+	}
+
+	return &caller{
+		File:     c.GetFile(),
+		Line:     c.GetLine(),
+		Function: c.GetFunction(),
+	}
 }
 
 func Decode(b []byte) (*event.Event, error) {
@@ -43,6 +110,12 @@ func Decode(b []byte) (*event.Event, error) {
 
 	log.Time = timestamppb.New(e.Time)
 
+	if e.Caller != nil {
+		log.Caller = event.NewCallerFrom(e.Caller.File, int(e.Caller.Line), e.Caller.Function)
+	}
+
+	log.Error = e.Error.decode()
+
 	return log, nil
 }
 
@@ -51,7 +124,7 @@ func Decode(b []byte) (*event.Event, error) {
 // This method will process the input event.Event and marshal it according to this LogFormatter
 func (f *FmtJSON) Format(log *event.Event) (buf []byte, err error) {
 	// remove trailing newline on JSON format
-	if log.GetMsg()[len(log.GetMsg())-1] == 10 {
+	if msg := log.GetMsg(); len(msg) > 0 && msg[len(msg)-1] == 10 {
 		*log.Msg = log.GetMsg()[:len(log.GetMsg())-1]
 	}
 
@@ -63,6 +136,8 @@ func (f *FmtJSON) Format(log *event.Event) (buf []byte, err error) {
 			Level:  log.GetLevel().String(),
 			Msg:    log.GetMsg(),
 			Meta:   log.Meta.AsMap(),
+			Caller: newCaller(log.GetCaller()),
+			Error:  newError(log.GetError()),
 		})
 	} else {
 		buf, err = json.MarshalIndent(entry{
@@ -72,6 +147,8 @@ func (f *FmtJSON) Format(log *event.Event) (buf []byte, err error) {
 			Level:  log.GetLevel().String(),
 			Msg:    log.GetMsg(),
 			Meta:   log.Meta.AsMap(),
+			Caller: newCaller(log.GetCaller()),
+			Error:  newError(log.GetError()),
 		}, "", "  ")
 	}
 
@@ -1,6 +1,8 @@
 package json
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -41,6 +43,21 @@ func TestFormat(t *testing.T) {
 			f:    &FmtJSON{SkipNewline: true},
 			e:    event.New().Message("null\n").Build(),
 		},
+		{
+			name: "event with caller",
+			f:    new(FmtJSON),
+			e:    event.New().Prefix("test").Level(event.Level_debug).Message("null").Caller(0).Build(),
+		},
+		{
+			name: "event with error",
+			f:    new(FmtJSON),
+			e:    event.New().Prefix("test").Level(event.Level_error).Message("null").Err(fmt.Errorf("wrap: %w", errors.New("inner"))).Build(),
+		},
+		{
+			name: "event with an empty message",
+			f:    new(FmtJSON),
+			e:    event.New().Prefix("test").Message("").Build(),
+		},
 	}
 
 	var verify = func(idx int, test test) {
@@ -1,8 +1,10 @@
 package protobuf
 
 import (
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/zalgonoise/zlog/log/event"
 )
@@ -25,6 +27,21 @@ func TestFormat(t *testing.T) {
 			name: "complete event",
 			e:    event.New().Prefix("test").Sub("testing").Level(event.Level_warn).Message("null").Metadata(event.Field{"a": true}).CallStack(true).Build(),
 		},
+		{
+			name: "event with caller",
+			e:    event.New().Prefix("test").Level(event.Level_debug).Message("null").Caller(0).Build(),
+		},
+		{
+			name: "event with lossless metadata",
+			e: event.New().Message("null").Metadata(event.Field{
+				"int":      int64(1<<62 + 1),
+				"uint":     uint64(math.MaxUint64),
+				"time":     time.Unix(1660298400, 123456789).UTC(),
+				"duration": 1500 * time.Nanosecond,
+				"bytes":    []byte("null"),
+				"a":        true,
+			}).Build(),
+		},
 	}
 
 	var verify = func(idx int, test test) {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/log/trace"
 )
 
 const (
@@ -236,19 +237,59 @@ func (f *FmtText) Format(log *event.Event) (buf []byte, err error) {
 				sb.WriteString("\t")
 			}
 		}
+
+		if log.GetCaller() != nil {
+			// (...) [dir/file.go:42] (...)
+			sb.WriteString("[")
+			sb.WriteString(log.GetCaller().Short())
+			sb.WriteString("]\t")
+			if f.doubleSpace {
+				sb.WriteString("\t")
+			}
+		}
 	}
 
 	sb.WriteString(log.GetMsg())
 
-	if len(log.GetMeta().AsMap()) > 0 {
+	meta := log.GetMeta().AsMap()
+
+	// call stacks are written in the following lines, in a compact format
+	var callstack trace.Stack
+
+	if cs, ok := meta[trace.Key].(map[string]interface{}); ok {
+		if s, ok := trace.FromMap(cs); ok {
+			callstack = s
+			delete(meta, trace.Key)
+		}
+	}
+
+	if len(meta) > 0 {
+		sb.WriteString("\t")
+		if f.doubleSpace {
+			sb.WriteString("\t")
+		}
+		sb.WriteString(f.FmtMetadata(meta))
+	}
+
+	if log.GetError() != nil {
 		sb.WriteString("\t")
 		if f.doubleSpace {
 			sb.WriteString("\t")
 		}
-		sb.WriteString(f.FmtMetadata(log.GetMeta().AsMap()))
+		sb.WriteString(f.FmtError(log.GetError()))
 	}
 	sb.WriteString("\n")
 
+	// stack traces are written in the following lines
+	if stack := log.GetError().GetStack(); stack != "" {
+		sb.WriteString(stack)
+		if !strings.HasSuffix(stack, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString(callstack.String())
+
 	buf = []byte(sb.String())
 	return
 }
@@ -378,3 +419,41 @@ func (f *FmtText) FmtMetadata(data map[string]interface{}) string {
 
 	return sb.String()
 }
+
+// FmtError method converts an event.Error into a human readable string, containing its message,
+// type and the chain of wrapped errors (without its stack trace)
+func (f *FmtText) FmtError(err *event.Error) string {
+	if err == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("[ error = \"")
+	sb.WriteString(err.GetMessage())
+	sb.WriteString("\" ; type = ")
+	sb.WriteString(err.GetType())
+	sb.WriteString(" ")
+
+	if len(err.GetChain()) > 0 {
+		sb.WriteString("; chain = [ ")
+
+		for idx, c := range err.GetChain() {
+			sb.WriteString("\"")
+			sb.WriteString(c.GetMessage())
+			sb.WriteString("\" (")
+			sb.WriteString(c.GetType())
+			sb.WriteString(") ")
+
+			if idx < len(err.GetChain())-1 {
+				sb.WriteString("; ")
+			}
+		}
+
+		sb.WriteString("] ")
+	}
+
+	sb.WriteString("] ")
+
+	return sb.String()
+}
@@ -1,6 +1,8 @@
 package text
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"regexp"
 	"testing"
@@ -159,6 +161,24 @@ func TestFormat(t *testing.T) {
 			f:     New().Color().Upper().Build(),
 			regex: `\[\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}.\d+Z\]\s+\[\\[36mINFO\\[0m\]\s+\[TEST\]\s+\[TESTING\]\s+null\s+\[ a = true \]`,
 		},
+		{
+			name:  "event with caller",
+			e:     event.New().Prefix("test").Message("null").Caller(0).Build(),
+			f:     New().Build(),
+			regex: `\[\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}.\d+Z\]\s+\[info\]\s+\[test\]\s+\[text/text_test.go:\d+\]\s+null`,
+		},
+		{
+			name:  "event with error",
+			e:     event.New().Prefix("test").Message("null").Err(fmt.Errorf("wrap: %w", errors.New("inner"))).Build(),
+			f:     New().Build(),
+			regex: `\[\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}.\d+Z\]\s+\[info\]\s+\[test\]\s+null\s+\[ error = "wrap: inner" ; type = \*fmt.wrapError ; chain = \[ "inner" \(\*errors.errorString\) \] \]`,
+		},
+		{
+			name:  "event with callstack",
+			e:     event.New().Prefix("test").Message("null").Metadata(event.Field{"a": true}).CallStack(false).Build(),
+			f:     New().Build(),
+			regex: `(?s)\[info\]\s+\[test\]\s+null\s+\[ a = true \] \ngoroutine \d+ \[running\]:\n\tgithub.com/zalgonoise/zlog/log/format/text.TestFormat \(text/text_test.go:\d+\)\n$`,
+		},
 	}
 
 	var init = func(test test) ([]byte, error) {
@@ -18,6 +18,59 @@ type entry struct {
 	Level    string    `xml:"level,omitempty"`
 	Msg      string    `xml:"message,omitempty"`
 	Metadata []Field   `xml:"metadata,omitempty"`
+	Caller   *caller   `xml:"caller,omitempty"`
+	Error    *errEntry `xml:"error,omitempty"`
+}
+
+type caller struct {
+	File     string `xml:"file,omitempty"`
+	Line     int32  `xml:"line,omitempty"`
+	Function string `xml:"function,omitempty"`
+}
+
+type errEntry struct {
+	Message string  `xml:"message,omitempty"`
+	Type    string  `xml:"type,omitempty"`
+	Chain   []cause `xml:"chain>cause,omitempty"`
+	Stack   string  `xml:"stack,omitempty"`
+}
+
+type cause struct {
+	Message string `xml:"message,omitempty"`
+	Type    string `xml:"type,omitempty"`
+}
+
+func newError(err *e.Error) *errEntry {
+	if err == nil {
+		return nil
+	}
+
+	out := &errEntry{
+		Message: err.GetMessage(),
+		Type:    err.GetType(),
+		Stack:   err.GetStack(),
+	}
+
+	for _, c := range err.GetChain() {
+		out.Chain = append(out.Chain, cause{
+			Message: c.GetMessage(),
+			Type:    c.GetType(),
+		})
+	}
+
+	return out
+}
+
+func newCaller(c *e.Caller) *caller {
+	if c == nil {
+		return nil
+	}
+
+	return &caller{
+		File:     c.GetFile(),
+		Line:     c.GetLine(),
+		Function: c.GetFunction(),
+	}
 }
 
 // Format method will take in a pointer to an event.Event; and returns a buffer and an error.
@@ -38,6 +91,8 @@ func (f *FmtXML) Format(log *e.Event) (buf []byte, err error) {
 		Level:    log.GetLevel().String(),
 		Msg:      log.GetMsg(),
 		Metadata: Mappify(meta),
+		Caller:   newCaller(log.GetCaller()),
+		Error:    newError(log.GetError()),
 	}
 
 	return xml.Marshal(xmlMsg)
@@ -2,6 +2,8 @@ package xml
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"reflect"
 	"regexp"
 	"testing"
@@ -38,6 +40,16 @@ func TestFormat(t *testing.T) {
 			e:     event.New().Prefix("test").Sub("testing").Level(event.Level_warn).Message("null").Metadata(event.Field{"a": true}).Build(),
 			regex: `<entry><timestamp>\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d+Z<\/timestamp><service>test<\/service><module>testing<\/module><level>warn<\/level><message>null<\/message><metadata><key>a<\/key><value>true<\/value><\/metadata><\/entry>`,
 		},
+		{
+			name:  "event with caller",
+			e:     event.New().Message("null").Caller(0).Build(),
+			regex: `<message>null<\/message><caller><file>[^<]+xml_test.go<\/file><line>\d+<\/line><function>[^<]+TestFormat<\/function><\/caller><\/entry>`,
+		},
+		{
+			name:  "event with error",
+			e:     event.New().Message("null").Err(fmt.Errorf("wrap: %w", errors.New("inner"))).Build(),
+			regex: `<message>null<\/message><error><message>wrap: inner<\/message><type>\*fmt.wrapError<\/type><chain><cause><message>inner<\/message><type>\*errors.errorString<\/type><\/cause><\/chain><\/error><\/entry>`,
+		},
 	}
 
 	var verify = func(idx int, test test) {
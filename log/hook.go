@@ -0,0 +1,98 @@
+package log
+
+import (
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// HookFunc type describes a function that is executed against an event.Event before it is
+// formatted and written.
+//
+// It receives a pointer to the event.Event, which allows it to enrich it (e.g. adding computed
+// metadata fields) or to copy it (e.g. with proto.Clone() to forward it elsewhere). The returned
+// boolean defines whether the event.Event should still be written (true) or vetoed (false).
+type HookFunc func(m *event.Event) bool
+
+// Hook struct describes a HookFunc that is executed only against event.Events of certain levels.
+// A Hook without levels is executed against all events.
+type Hook struct {
+	levels []event.Level
+	fn     HookFunc
+}
+
+// Hooks type is a set of Hook that is executed in order
+type Hooks []Hook
+
+// NewHook function will create a Hook from the input HookFunc, which will be executed against
+// event.Events of the input levels. If no levels are provided, it is executed against all events.
+func NewHook(fn HookFunc, levels ...event.Level) Hook {
+	return Hook{
+		levels: levels,
+		fn:     fn,
+	}
+}
+
+// Fire method will execute the Hook's HookFunc against the input event.Event, if its level
+// matches the Hook's. It returns false if the event.Event was vetoed by the HookFunc.
+func (h Hook) Fire(m *event.Event) bool {
+	if h.fn == nil {
+		return true
+	}
+
+	if len(h.levels) > 0 {
+		var match bool
+
+		for _, level := range h.levels {
+			if level == m.GetLevel() {
+				match = true
+				break
+			}
+		}
+
+		if !match {
+			return true
+		}
+	}
+
+	return h.fn(m)
+}
+
+// Fire method will execute all Hooks against the input event.Event, in order. If any of the
+// Hooks vetoes the event.Event, the remaining ones are skipped and the method returns false.
+func (h Hooks) Fire(m *event.Event) bool {
+	for _, hook := range h {
+		if !hook.Fire(m) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LCHook struct is a custom LoggerConfig to add Hooks to new Loggers
+type LCHook struct {
+	h Hook
+}
+
+// Apply method will add the configured Hook to the input pointer to a LoggerBuilder's Hooks
+func (c *LCHook) Apply(lb *LoggerBuilder) {
+	lb.Hooks = append(lb.Hooks, c.h)
+}
+
+// WithHook function will allow creating a LoggerConfig that adds a Hook to a Logger, executing
+// the input HookFunc against events of the input levels (or all events, if none are provided).
+//
+// Hooks are executed in the same order as they are configured, after the Logger applies its
+// defaults to the event (prefix, sub-prefix and metadata) and before it is formatted. Hooks are
+// only executed against events that pass the Logger's level filter.
+//
+// Loggers within a MultiLogger execute their own Hooks; as they share the same event.Event, any
+// changes applied to it by a Hook are visible to the following Loggers.
+func WithHook(fn HookFunc, levels ...event.Level) LoggerConfig {
+	if fn == nil {
+		return nil
+	}
+
+	return &LCHook{
+		h: NewHook(fn, levels...),
+	}
+}
@@ -0,0 +1,162 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestWithHook(t *testing.T) {
+	module := "Hook"
+	funcname := "WithHook()"
+
+	type test struct {
+		name   string
+		hooks  []LoggerConfig
+		events []*event.Event
+		wants  []string
+		skips  []string
+	}
+
+	var tests = []test{
+		{
+			name: "enrich all events",
+			hooks: []LoggerConfig{
+				WithHook(func(m *event.Event) bool {
+					meta := m.GetMeta().AsMap()
+					meta["hooked"] = true
+					m.Meta = event.Field(meta).Encode()
+					return true
+				}),
+			},
+			events: []*event.Event{
+				event.New().Level(event.Level_info).Message("first").Build(),
+				event.New().Level(event.Level_error).Message("second").Build(),
+			},
+			wants: []string{`"message":"first","metadata":{"hooked":true}`, `"message":"second","metadata":{"hooked":true}`},
+		},
+		{
+			name: "veto debug events",
+			hooks: []LoggerConfig{
+				WithHook(func(m *event.Event) bool { return false }, event.Level_debug),
+			},
+			events: []*event.Event{
+				event.New().Level(event.Level_info).Message("first").Build(),
+				event.New().Level(event.Level_debug).Message("second").Build(),
+			},
+			wants: []string{`"message":"first"`},
+			skips: []string{`"message":"second"`},
+		},
+		{
+			name: "hooks run in order; veto skips the remaining",
+			hooks: []LoggerConfig{
+				WithHook(func(m *event.Event) bool {
+					return m.GetMsg() != "second"
+				}),
+				WithHook(func(m *event.Event) bool {
+					*m.Msg = m.GetMsg() + "-hooked"
+					return true
+				}),
+			},
+			events: []*event.Event{
+				event.New().Level(event.Level_info).Message("first").Build(),
+				event.New().Level(event.Level_info).Message("second").Build(),
+			},
+			wants: []string{`"message":"first-hooked"`},
+			skips: []string{`"message":"second`},
+		},
+		{
+			name:  "nil hook func",
+			hooks: []LoggerConfig{WithHook(nil)},
+			events: []*event.Event{
+				event.New().Level(event.Level_info).Message("first").Build(),
+			},
+			wants: []string{`"message":"first"`},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		buf := new(bytes.Buffer)
+		logger := New(append([]LoggerConfig{WithOut(buf), SkipExit, CfgFormatJSON}, test.hooks...)...)
+
+		logger.Log(test.events...)
+
+		for _, w := range test.wants {
+			if !strings.Contains(buf.String(), w) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] output mismatch error: expected %q in %q -- action: %s",
+					idx,
+					module,
+					funcname,
+					w,
+					buf.String(),
+					test.name,
+				)
+				return
+			}
+		}
+
+		for _, s := range test.skips {
+			if strings.Contains(buf.String(), s) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] output mismatch error: unexpected %q in %q -- action: %s",
+					idx,
+					module,
+					funcname,
+					s,
+					buf.String(),
+					test.name,
+				)
+				return
+			}
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestMultiLoggerHooks(t *testing.T) {
+	module := "Hook"
+	funcname := "MultiLogger()"
+
+	var count int
+	buf1 := new(bytes.Buffer)
+	buf2 := new(bytes.Buffer)
+
+	logger := MultiLogger(
+		New(WithOut(buf1), SkipExit, CfgTextOnly, WithHook(func(m *event.Event) bool {
+			count++
+			return true
+		})),
+		New(WithOut(buf2), SkipExit, CfgTextOnly, WithHook(func(m *event.Event) bool {
+			return false
+		}, event.Level_warn)),
+	)
+
+	logger.Info("info")
+	logger.Warn("warn")
+
+	if count != 2 {
+		t.Errorf("[%s] [%s] expected the first logger's hook to run twice; got %v", module, funcname, count)
+	}
+
+	if buf1.String() != "info\nwarn\n" {
+		t.Errorf("[%s] [%s] unexpected output in the first logger: %q", module, funcname, buf1.String())
+	}
+
+	if buf2.String() != "info\n" {
+		t.Errorf("[%s] [%s] unexpected output in the second logger: %q", module, funcname, buf2.String())
+	}
+}
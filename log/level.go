@@ -0,0 +1,64 @@
+package log
+
+import (
+	"sync/atomic"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// Leveler interface describes a Logger whose level filter can be read and changed while
+// it is in use
+//
+// Loggers created with New() implement this interface, as well as MultiLoggers (which apply the
+// new level to all of their Loggers)
+type Leveler interface {
+	Level() event.Level
+	SetLevel(level event.Level)
+}
+
+// Level method returns the Logger's current level filter (shared with its parent, if
+// it is a child Logger)
+func (l *logger) Level() event.Level {
+	return event.Level(atomic.LoadInt32(&l.root().levelFilter))
+}
+
+// SetLevel method will atomically replace the Logger's level filter, which takes effect
+// on the next written event
+func (l *logger) SetLevel(level event.Level) {
+	atomic.StoreInt32(&l.root().levelFilter, level.Int())
+}
+
+// Level method returns the lowest level filter among the multiLogger's Loggers, or
+// event.Level_trace if none of them implements Leveler
+func (l *multiLogger) Level() event.Level {
+	var (
+		level event.Level = event.Level_panic
+		found bool
+	)
+
+	for _, logger := range l.loggers {
+		if lv, ok := logger.(Leveler); ok {
+			found = true
+
+			if lv.Level().Int() < level.Int() {
+				level = lv.Level()
+			}
+		}
+	}
+
+	if !found {
+		return event.Level_trace
+	}
+
+	return level
+}
+
+// SetLevel method will set the input level filter on all of the multiLogger's Loggers
+// which implement Leveler
+func (l *multiLogger) SetLevel(level event.Level) {
+	for _, logger := range l.loggers {
+		if lv, ok := logger.(Leveler); ok {
+			lv.SetLevel(level)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestSetLevel(t *testing.T) {
+	module := "Logger"
+	funcname := "SetLevel()"
+
+	type test struct {
+		name  string
+		level event.Level
+		event *event.Event
+		ok    bool
+	}
+
+	var tests = []test{
+		{
+			name:  "debug event with an info filter",
+			level: event.Level_info,
+			event: event.New().Level(event.Level_debug).Message("null").Build(),
+		},
+		{
+			name:  "debug event with a debug filter",
+			level: event.Level_debug,
+			event: event.New().Level(event.Level_debug).Message("null").Build(),
+			ok:    true,
+		},
+		{
+			name:  "warn event with an error filter",
+			level: event.Level_error,
+			event: event.New().Level(event.Level_warn).Message("null").Build(),
+		},
+		{
+			name:  "error event with a trace filter",
+			level: event.Level_trace,
+			event: event.New().Level(event.Level_error).Message("null").Build(),
+			ok:    true,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		buf1 := new(bytes.Buffer)
+		buf2 := new(bytes.Buffer)
+
+		l1 := New(WithOut(buf1), SkipExit, CfgTextOnly, WithFilter(event.Level_warn))
+		l2 := New(WithOut(buf2), SkipExit, CfgTextOnly)
+		ml := MultiLogger(l1, l2)
+
+		ml.(Leveler).SetLevel(test.level)
+
+		for _, l := range []Logger{l1, l2, ml} {
+			if level := l.(Leveler).Level(); level != test.level {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] level mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.level, level, test.name)
+				return
+			}
+		}
+
+		ml.Log(test.event)
+
+		if (buf1.Len() > 0) != test.ok || (buf2.Len() > 0) != test.ok {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected output: %q ; %q -- action: %s", idx, module, funcname, buf1.String(), buf2.String(), test.name)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
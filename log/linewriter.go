@@ -0,0 +1,293 @@
+package log
+
+import (
+	"bytes"
+	stdlog "log"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+const defaultMaxLineSize int = 64 * 1024
+
+// levelTokens lists the (lowercase) leading tokens recognized as a level by a LineWriter
+var levelTokens = map[string]event.Level{
+	"trace":    event.Level_trace,
+	"debug":    event.Level_debug,
+	"info":     event.Level_info,
+	"warn":     event.Level_warn,
+	"warning":  event.Level_warn,
+	"error":    event.Level_error,
+	"err":      event.Level_error,
+	"fatal":    event.Level_fatal,
+	"panic":    event.Level_panic,
+	"critical": event.Level_fatal,
+}
+
+// LineWriterOptions struct defines the optional settings for a LineWriter
+type LineWriterOptions struct {
+	// MaxLineSize sets the maximum length of a line; longer lines are split into several
+	// events. Defaults to 64KiB
+	MaxLineSize int
+
+	// ParseLevel enables reading a leading level token in each line (such as `ERROR:`, `[warn]`
+	// or `DEBUG `), which is removed from the message and replaces the LineWriter's level
+	ParseLevel bool
+}
+
+// LineWriter struct is an io.Writer which buffers its input and writes each line (terminated
+// by a newline) as an event in a Logger.
+//
+// Unlike writing to a Logger directly (where each Write() call is an event), a LineWriter handles
+// writers which call Write() with partial or multiple lines, such as the standard library's
+// log package, http.Server's ErrorLog or an exec.Cmd's Stdout / Stderr. A trailing partial line
+// is kept until it is completed, or until Flush() or Close() are called.
+//
+// A LineWriter is safe for concurrent use
+type LineWriter struct {
+	mu         sync.Mutex
+	logger     Logger
+	level      event.Level
+	buf        []byte
+	maxLen     int
+	parseLevel bool
+}
+
+// NewLineWriter function creates a LineWriter that writes each line as an event with the input
+// level in the input Logger, configured with the input LineWriterOptions (if not nil).
+//
+// If the input Logger is nil, the standard Logger is used
+func NewLineWriter(logger Logger, level event.Level, opts *LineWriterOptions) *LineWriter {
+	if logger == nil {
+		logger = std
+	}
+
+	w := &LineWriter{
+		logger: logger,
+		level:  level,
+		maxLen: defaultMaxLineSize,
+	}
+
+	if opts != nil {
+		if opts.MaxLineSize > 0 {
+			w.maxLen = opts.MaxLineSize
+		}
+		w.parseLevel = opts.ParseLevel
+	}
+
+	return w
+}
+
+// Write method implements the io.Writer interface.
+//
+// It writes each complete line in the buffered input as an event, keeping any trailing partial
+// line in the buffer; splitting lines that exceed the maximum line size. It always consumes the
+// whole input, returning the first error raised when writing the events
+func (w *LineWriter) Write(p []byte) (n int, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+
+	for {
+		idx := bytes.IndexByte(w.buf, '\n')
+
+		if idx < 0 {
+			break
+		}
+
+		if lerr := w.writeLine(w.buf[:idx]); lerr != nil && err == nil {
+			err = lerr
+		}
+
+		w.buf = w.buf[idx+1:]
+	}
+
+	// split a partial line that is already too long
+	for len(w.buf) > w.maxLen {
+		idx := w.cut(w.buf)
+
+		if lerr := w.writeEvent(w.buf[:idx]); lerr != nil && err == nil {
+			err = lerr
+		}
+
+		w.buf = w.buf[idx:]
+	}
+
+	// release the consumed part of the buffer
+	if len(w.buf) == 0 {
+		w.buf = nil
+	}
+
+	return len(p), err
+}
+
+// Flush method writes the buffered partial line (if any) as an event
+func (w *LineWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	err := w.writeLine(w.buf)
+	w.buf = nil
+
+	return err
+}
+
+// Close method implements the io.Closer interface, flushing any buffered partial line
+func (w *LineWriter) Close() error {
+	return w.Flush()
+}
+
+// writeLine method writes the input line as one event, or as several if it exceeds the
+// maximum line size
+func (w *LineWriter) writeLine(line []byte) (err error) {
+	for len(line) > w.maxLen {
+		idx := w.cut(line)
+
+		if lerr := w.writeEvent(line[:idx]); lerr != nil && err == nil {
+			err = lerr
+		}
+
+		line = line[idx:]
+	}
+
+	if lerr := w.writeEvent(line); lerr != nil && err == nil {
+		err = lerr
+	}
+
+	return err
+}
+
+// cut method returns the index where the input (too long) line is split, which is at the maximum
+// line size or before it, so that UTF-8 characters are not split
+func (w *LineWriter) cut(line []byte) int {
+	if len(line) <= w.maxLen {
+		return len(line)
+	}
+
+	idx := w.maxLen
+
+	for idx > 0 && !utf8.RuneStart(line[idx]) {
+		idx--
+	}
+
+	if idx == 0 {
+		return w.maxLen
+	}
+
+	return idx
+}
+
+// writeEvent method writes the input line as an event. Empty lines are ignored
+func (w *LineWriter) writeEvent(line []byte) error {
+	msg := strings.TrimRightFunc(string(line), unicode.IsSpace)
+
+	if msg == "" {
+		return nil
+	}
+
+	level := w.level
+
+	if w.parseLevel {
+		if lv, rest, ok := parseLevelToken(msg); ok {
+			level, msg = lv, rest
+		}
+	}
+
+	_, err := w.logger.Output(event.New().
+		Level(level).
+		Message(msg).
+		Build())
+
+	return err
+}
+
+// parseLevelToken function reads a leading level token in the input string, as one of:
+//
+//	ERROR: message
+//	[error] message
+//	ERROR message
+//
+// The last form is only read from uppercase tokens. It returns the level, the remaining message
+// and true if a known level is found
+func parseLevelToken(s string) (event.Level, string, bool) {
+	var (
+		token string
+		rest  string
+	)
+
+	if strings.HasPrefix(s, "[") {
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return 0, s, false
+		}
+
+		token, rest = s[1:end], s[end+1:]
+	} else {
+		end := strings.IndexFunc(s, func(r rune) bool {
+			return r == ':' || unicode.IsSpace(r)
+		})
+		if end < 0 {
+			return 0, s, false
+		}
+
+		token, rest = s[:end], s[end:]
+
+		// a token followed by a space must be uppercase, to avoid reading a level from a
+		// message starting with one of these words (e.g. "Error connecting to ...")
+		if strings.HasPrefix(rest, ":") {
+			rest = rest[1:]
+		} else if token != strings.ToUpper(token) {
+			return 0, s, false
+		}
+	}
+
+	level, ok := levelTokens[strings.ToLower(token)]
+	if !ok {
+		return 0, s, false
+	}
+
+	return level, strings.TrimLeftFunc(rest, unicode.IsSpace), true
+}
+
+// NewStdLogger function returns a standard library *log.Logger which writes each line as an event
+// in the input Logger, with the input level; to be used with APIs that expect one (such as
+// http.Server's ErrorLog). Leading level tokens in the lines (like `ERROR:`) replace the level.
+//
+// The returned logger does not add its own timestamps, as the events carry them
+func NewStdLogger(logger Logger, level event.Level) *stdlog.Logger {
+	return stdlog.New(NewLineWriter(logger, level, &LineWriterOptions{ParseLevel: true}), "", 0)
+}
+
+// RedirectStdLog function redirects the standard library's log package (log.Print(), log.Fatal(),
+// ...) to the input Logger, writing each line as an event with the input level. Leading level
+// tokens in the lines (like `ERROR:`) replace the level.
+//
+// It returns a function which restores the standard library logger's previous output, flags and
+// prefix:
+//
+//	restore := log.RedirectStdLog(logger, event.Level_info)
+//	defer restore()
+func RedirectStdLog(logger Logger, level event.Level) func() {
+	out, flags, prefix := stdlog.Writer(), stdlog.Flags(), stdlog.Prefix()
+	w := NewLineWriter(logger, level, &LineWriterOptions{ParseLevel: true})
+
+	stdlog.SetOutput(w)
+	stdlog.SetFlags(0)
+	stdlog.SetPrefix("")
+
+	return func() {
+		_ = w.Flush() // deliberately ignore error in this method call
+
+		stdlog.SetOutput(out)
+		stdlog.SetFlags(flags)
+		stdlog.SetPrefix(prefix)
+	}
+}
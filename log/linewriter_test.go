@@ -0,0 +1,193 @@
+package log
+
+import (
+	"bytes"
+	stdlog "log"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+type line struct {
+	level event.Level
+	msg   string
+}
+
+// captureLines function creates a Logger which stores the level and message of the events it
+// writes in the returned slice
+func captureLines() (Logger, *[]line) {
+	var lines []line
+
+	logger := New(WithOut(new(bytes.Buffer)), SkipExit, WithHook(func(m *event.Event) bool {
+		lines = append(lines, line{m.GetLevel(), m.GetMsg()})
+		return true
+	}))
+
+	return logger, &lines
+}
+
+func TestLineWriter(t *testing.T) {
+	module := "LineWriter"
+	funcname := "Write()"
+
+	type test struct {
+		name   string
+		opts   *LineWriterOptions
+		writes []string
+		flush  bool
+		wants  []line
+	}
+
+	var tests = []test{
+		{
+			name:   "single line",
+			writes: []string{"hello\n"},
+			wants:  []line{{event.Level_info, "hello"}},
+		},
+		{
+			name:   "multiple lines in one write",
+			writes: []string{"hello\nworld\r\n\n"},
+			wants:  []line{{event.Level_info, "hello"}, {event.Level_info, "world"}},
+		},
+		{
+			name:   "partial writes",
+			writes: []string{"hel", "lo\nwor", "ld\n"},
+			wants:  []line{{event.Level_info, "hello"}, {event.Level_info, "world"}},
+		},
+		{
+			name:   "partial line is kept until flushed",
+			writes: []string{"hello\nwor"},
+			wants:  []line{{event.Level_info, "hello"}},
+		},
+		{
+			name:   "flush a partial line",
+			writes: []string{"hello\nwor"},
+			flush:  true,
+			wants:  []line{{event.Level_info, "hello"}, {event.Level_info, "wor"}},
+		},
+		{
+			name:   "split long lines",
+			opts:   &LineWriterOptions{MaxLineSize: 4},
+			writes: []string{"abcdefghij\n"},
+			wants:  []line{{event.Level_info, "abcd"}, {event.Level_info, "efgh"}, {event.Level_info, "ij"}},
+		},
+		{
+			name:   "split long partial lines",
+			opts:   &LineWriterOptions{MaxLineSize: 4},
+			writes: []string{"abcdef", "ghi"},
+			wants:  []line{{event.Level_info, "abcd"}, {event.Level_info, "efgh"}},
+		},
+		{
+			name:   "split long lines without breaking characters",
+			opts:   &LineWriterOptions{MaxLineSize: 4},
+			writes: []string{"abcñdef\n"},
+			wants:  []line{{event.Level_info, "abc"}, {event.Level_info, "ñde"}, {event.Level_info, "f"}},
+		},
+		{
+			name:   "ignore level tokens if not parsing",
+			writes: []string{"ERROR: failed\n"},
+			wants:  []line{{event.Level_info, "ERROR: failed"}},
+		},
+		{
+			name: "parse level tokens",
+			opts: &LineWriterOptions{ParseLevel: true},
+			writes: []string{
+				"ERROR: failed\n",
+				"[warn] slow query\n",
+				"DEBUG connected\n",
+				"Error connecting to db\n",
+				"http: TLS handshake error\n",
+			},
+			wants: []line{
+				{event.Level_error, "failed"},
+				{event.Level_warn, "slow query"},
+				{event.Level_debug, "connected"},
+				{event.Level_info, "Error connecting to db"},
+				{event.Level_info, "http: TLS handshake error"},
+			},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		logger, lines := captureLines()
+		w := NewLineWriter(logger, event.Level_info, test.opts)
+
+		for _, p := range test.writes {
+			n, err := w.Write([]byte(p))
+
+			if err != nil || n != len(p) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected write result: %v ; %v -- action: %s", idx, module, funcname, n, err, test.name)
+				return
+			}
+		}
+
+		if test.flush {
+			if err := w.Flush(); err != nil {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected flush error: %v -- action: %s", idx, module, funcname, err, test.name)
+				return
+			}
+		}
+
+		if !reflect.DeepEqual(*lines, test.wants) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, *lines, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestNewStdLogger(t *testing.T) {
+	module := "LineWriter"
+	funcname := "NewStdLogger()"
+
+	logger, lines := captureLines()
+
+	std := NewStdLogger(logger, event.Level_warn)
+	std.Printf("multi\nline")
+	std.Print("ERROR: failed")
+
+	wants := []line{{event.Level_warn, "multi"}, {event.Level_warn, "line"}, {event.Level_error, "failed"}}
+
+	if !reflect.DeepEqual(*lines, wants) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: wanted %v ; got %v -- action: %s", 0, module, funcname, wants, *lines, "write through a stdlib logger")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "write through a stdlib logger")
+}
+
+func TestRedirectStdLog(t *testing.T) {
+	module := "LineWriter"
+	funcname := "RedirectStdLog()"
+
+	buf := new(bytes.Buffer)
+	stdlog.SetOutput(buf)
+	defer stdlog.SetOutput(stdout)
+
+	logger, lines := captureLines()
+
+	restore := RedirectStdLog(logger, event.Level_info)
+	stdlog.Println("redirected")
+	restore()
+
+	stdlog.Println("restored")
+
+	if wants := []line{{event.Level_info, "redirected"}}; !reflect.DeepEqual(*lines, wants) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: wanted %v ; got %v -- action: %s", 0, module, funcname, wants, *lines, "redirect the stdlib logger")
+		return
+	}
+
+	if !strings.Contains(buf.String(), "restored") || strings.Contains(buf.String(), "redirected") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected stdlib output: %q -- action: %s", 1, module, funcname, buf.String(), "restore the stdlib logger")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "redirect the stdlib logger")
+}
@@ -1,6 +1,8 @@
 package logch
 
 import (
+	"sync"
+
 	"github.com/zalgonoise/zlog/log"
 	"github.com/zalgonoise/zlog/log/event"
 )
@@ -11,16 +13,28 @@ import (
 // with the goroutine, and not just to spawn it and retrieve the needed channels.
 type ChanneledLogger interface {
 	Log(msg ...*event.Event)
-	Close()
+	Sync() error
+	Close() error
 	Channels() (logCh chan *event.Event, done chan struct{})
 }
 
 // LogChannel struct defines what a minimal logging channel must contain:
 //   - a channel to receive pointers to event.Event
 //   - a channel to receive a done signal (to close the goroutine)
+//   - a channel to receive sync requests, acknowledged once the previous messages are written
+//   - the Logger it writes to, and its state (shared across copies of the LogChannel)
 type LogChannel struct {
-	logCh chan *event.Event
-	done  chan struct{}
+	logCh  chan *event.Event
+	done   chan struct{}
+	syncCh chan chan struct{}
+	logger log.Logger
+	state  *state
+}
+
+// state struct holds whether a LogChannel is closed, guarded by a read-write lock
+type state struct {
+	mu     sync.RWMutex
+	closed bool
 }
 
 // New function is a helper to spawn a channeled logger function and
@@ -49,10 +63,14 @@ func New(logger log.Logger) (logCh ChanneledLogger) {
 
 	msgCh := make(chan *event.Event)
 	done := make(chan struct{})
+	syncCh := make(chan chan struct{})
 
 	logCh = &LogChannel{
-		logCh: msgCh,
-		done:  done,
+		logCh:  msgCh,
+		done:   done,
+		syncCh: syncCh,
+		logger: logger,
+		state:  &state{},
 	}
 
 	go func(done chan struct{}) {
@@ -60,6 +78,8 @@ func New(logger log.Logger) (logCh ChanneledLogger) {
 			select {
 			case msg := <-msgCh:
 				logger.Log(msg)
+			case ack := <-syncCh:
+				close(ack)
 			case <-done:
 				return
 			}
@@ -76,7 +96,9 @@ func New(logger log.Logger) (logCh ChanneledLogger) {
 // As these messages are queued, they will be then printed within the spawned goroutine, using a Logger.Log()
 // method call
 //
-// This method is a wrapper for not having to call the Channels() method, and then working with these separately
+// This method is a wrapper for not having to call the Channels() method, and then working with these separately.
+//
+// Messages sent after the LogChannel is closed are discarded
 //
 //     logger := log.New(log.WithPrefix("logger"), log.CfgTextFormat)
 //     logCh := NewLogCh(logger)
@@ -93,6 +115,13 @@ func (c LogChannel) Log(msg ...*event.Event) {
 		return
 	}
 
+	c.state.mu.RLock()
+	defer c.state.mu.RUnlock()
+
+	if c.state.closed {
+		return
+	}
+
 	for _, m := range msg {
 		if m != nil {
 			c.logCh <- m
@@ -100,8 +129,26 @@ func (c LogChannel) Log(msg ...*event.Event) {
 	}
 }
 
+// Sync method will block until the messages sent before the call are written, and then sync the
+// underlying Logger
+func (c LogChannel) Sync() error {
+	c.state.mu.RLock()
+
+	if !c.state.closed {
+		ack := make(chan struct{})
+		c.syncCh <- ack
+		<-ack
+	}
+
+	c.state.mu.RUnlock()
+
+	return c.logger.Sync()
+}
+
 // Close method will send a signal (an empty `struct{}`) to the done channel, triggering the spawned goroutine to
-// return
+// return once the messages sent before the call are written; and then close the underlying Logger.
+//
+// Further messages sent with Log() are discarded, and closing a LogChannel more than once is a no-op
 //
 //     logger := log.New(log.WithPrefix("logger"), log.CfgTextFormat)
 //     logCh := NewLogCh(logger)
@@ -113,8 +160,19 @@ func (c LogChannel) Log(msg ...*event.Event) {
 //
 //     logCh.Close()
 //
-func (c LogChannel) Close() {
+func (c LogChannel) Close() error {
+	c.state.mu.Lock()
+
+	if c.state.closed {
+		c.state.mu.Unlock()
+		return nil
+	}
+
+	c.state.closed = true
 	c.done <- struct{}{}
+	c.state.mu.Unlock()
+
+	return c.logger.Close()
 }
 
 // Channels method will return the LogMessage channel and the done channel, so that they can be used
@@ -1,6 +1,8 @@
 package logch
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/zalgonoise/zlog/log"
@@ -45,3 +47,47 @@ func TestNew(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestClose(t *testing.T) {
+	module := "LogCh"
+	funcname := "Close()"
+
+	buf := new(bytes.Buffer)
+	cl := New(log.New(log.WithOut(buf), log.CfgTextOnly))
+
+	cl.Log(event.New().Message("first").Build(), event.New().Message("second").Build())
+
+	if err := cl.Sync(); err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 0, module, funcname, err, "sync the channel")
+		return
+	}
+
+	if out := buf.String(); !strings.Contains(out, "first") || !strings.Contains(out, "second") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] events were not written before syncing: %q -- action: %s", 0, module, funcname, out, "sync the channel")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "sync the channel")
+
+	for i := 0; i < 2; i++ {
+		if err := cl.Close(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 1, module, funcname, err, "close the channel")
+			return
+		}
+	}
+
+	// discarded, without blocking
+	cl.Log(event.New().Message("third").Build())
+
+	if err := cl.Sync(); err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 1, module, funcname, err, "close the channel")
+		return
+	}
+
+	if out := buf.String(); strings.Contains(out, "third") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected event after closing: %q -- action: %s", 1, module, funcname, out, "close the channel")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "close the channel")
+}
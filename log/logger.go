@@ -17,7 +17,12 @@
 //	    Prefix(prefix string) Logger
 //	    Sub(sub string) Logger
 //	    Fields(fields map[string]interface{}) Logger
+//	    With(fields map[string]interface{}) Logger
+//	    Child(prefix, sub string, fields map[string]interface{}) Logger
 //	    IsSkipExit() bool
+//	    Sync() error
+//	    Close() error
+//	    Start(name string, fields map[string]interface{}) *Scope
 //	}
 //
 //	type Printer interface {
@@ -40,6 +45,9 @@
 //	    Errorln(v ...interface{})
 //	    Errorf(format string, v ...interface{})
 //
+//	    Err(err error, v ...interface{})
+//	    Errf(err error, format string, v ...interface{})
+//
 //	    Warn(v ...interface{})
 //	    Warnln(v ...interface{})
 //	    Warnf(format string, v ...interface{})
@@ -59,7 +67,7 @@
 //
 //	type ChanneledLogger interface {
 //	    Log(msg ...*event.Event)
-//	    Close()
+//	    Close() error
 //	    Channels() (logCh chan *event.Event, done chan struct{})
 //	}
 //
@@ -80,6 +88,7 @@ import (
 	"io"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/zalgonoise/zlog/log/event"
 	"github.com/zalgonoise/zlog/store"
@@ -90,7 +99,12 @@ import (
 // It lists all the methods that a Logger implements in order to print
 // timestamped messages to an io.Writer, and additional configuration
 // methods to enhance its behavior and application (such as `Prefix()`
-// and `Fields()`; and `SetOuts()` or `AddOuts()`)
+// and `Fields()`; and `SetOuts()` or `AddOuts()`), or to derive child
+// Loggers from it (with `With()` and `Child()`).
+//
+// Loggers also commit their buffered outputs with `Sync()`, and release them
+// with `Close()`; after which any writes return ErrClosed. Timed operations are
+// logged with `Start()`, which returns a Scope to be finished with `Scope.End()`
 type Logger interface {
 	io.Writer
 	Printer
@@ -100,7 +114,12 @@ type Logger interface {
 	Prefix(prefix string) Logger
 	Sub(sub string) Logger
 	Fields(fields map[string]interface{}) Logger
+	With(fields map[string]interface{}) Logger
+	Child(prefix, sub string, fields map[string]interface{}) Logger
 	IsSkipExit() bool
+	Sync() error
+	Close() error
+	Start(name string, fields map[string]interface{}) *Scope
 }
 
 var std = New(DefaultConfig)
@@ -113,12 +132,29 @@ var stdout = os.Stderr
 // all elements are set (with defaults or otherwise) it
 // is converted / copied into a Logger
 type LoggerBuilder struct {
-	Out         io.Writer
-	Prefix      string
-	Sub         string
-	Fmt         LogFormatter
-	SkipExit    bool
-	LevelFilter int32
+	Out          io.Writer
+	Prefix       string
+	Sub          string
+	Fmt          LogFormatter
+	SkipExit     bool
+	LevelFilter  int32
+	Sampler      *Sampler
+	Deduper      *Deduper
+	Hooks        Hooks
+	AsyncSize    int
+	AsyncPolicy  OverflowPolicy
+	Sinks        []Sink
+	Redactor     Redactor
+	Caller       bool
+	CallerSkip   []string
+	ExitFunc     func(code int)
+	PanicFunc    func(v interface{})
+	Closers      []io.Closer
+	ExitTimeout  time.Duration
+	ErrorHandler func(err error, ev *event.Event)
+	Fallback     io.Writer
+	FallbackFmt  LogFormatter
+	Scopes       *ScopeConfig
 }
 
 // New function allows creating a basic Logger (implementing the Logger
@@ -147,20 +183,56 @@ func New(confs ...LoggerConfig) Logger {
 		return &nilLogger{}
 	}
 
-	return &logger{
+	l := &logger{
 		out:         builder.Out,
 		prefix:      builder.Prefix,
 		sub:         builder.Sub,
 		fmt:         builder.Fmt,
 		skipExit:    builder.SkipExit,
 		levelFilter: builder.LevelFilter,
+		sampler:     builder.Sampler,
+		dedup:       builder.Deduper,
+		hooks:       builder.Hooks,
+		sinks:       builder.Sinks,
+		redactor:    builder.Redactor,
+		caller:      builder.Caller,
+		callerSkip:  builder.CallerSkip,
+		exitFn:      builder.ExitFunc,
+		panicFn:     builder.PanicFunc,
+		closers:     builder.Closers,
+		exitTimeout: builder.ExitTimeout,
+		errFn:       builder.ErrorHandler,
+		fallback:    newFallback(builder.Fallback, builder.FallbackFmt),
+		scopes:      builder.Scopes,
+	}
+
+	if builder.AsyncSize > 0 {
+		l.async = newAsyncQueue(builder.AsyncSize, builder.AsyncPolicy, func(m *event.Event) {
+			_, _ = l.write(m) // deliberately ignore error in this method call
+		})
+	}
+
+	if l.sampler != nil {
+		l.sampler.bind(func(m *event.Event) {
+			_, _ = l.output(m) // deliberately ignore error in this method call
+		})
 	}
+
+	if l.dedup != nil {
+		l.dedup.Bind(func(m *event.Event) {
+			_, _ = l.output(m) // deliberately ignore error in this method call
+		})
+	}
+
+	return l
 }
 
 // logger struct describes a basic Logger, which is used to print timestamped messages
 // to an io.Writer
 type logger struct {
+	counters    counters
 	mu          sync.Mutex
+	wmu         sync.Mutex
 	out         io.Writer
 	buf         []byte
 	prefix      string
@@ -169,13 +241,32 @@ type logger struct {
 	meta        map[string]interface{}
 	skipExit    bool
 	levelFilter int32
+	sampler     *Sampler
+	dedup       *Deduper
+	hooks       Hooks
+	async       *asyncQueue
+	sinks       []Sink
+	redactor    Redactor
+	caller      bool
+	callerSkip  []string
+	exitFn      func(code int)
+	panicFn     func(v interface{})
+	closers     []io.Closer
+	exitTimeout time.Duration
+	errFn       func(err error, ev *event.Event)
+	fallback    *fallback
+	scopes      *ScopeConfig
+	scope       *Scope
+	closeOnce   sync.Once
+	closed      int32
+	parent      *logger
 }
 
 // SetOuts method will set (replace) the defined io.Writer in the Logger with the list of
 // io.Writer set as `outs`.
 //
-// By default, these input io.Writer will be processed with an io.MultiWriter call to create
-// a wrapper for multiple io.Writers
+// By default, these input io.Writer will be processed with a store.MultiWriter call to create
+// a wrapper for multiple io.Writers, which keeps writing to the remaining io.Writers if one fails
 func (l *logger) SetOuts(outs ...io.Writer) Logger {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -193,7 +284,7 @@ func (l *logger) SetOuts(outs ...io.Writer) Logger {
 		return l
 	}
 
-	l.out = io.MultiWriter(newouts...)
+	l.out = store.MultiWriter(newouts...)
 
 	return l
 }
@@ -201,8 +292,8 @@ func (l *logger) SetOuts(outs ...io.Writer) Logger {
 // AddOuts method will add (append) the list of io.Writer set as `outs` to the defined
 // ioWriter in the logger
 //
-// By default, these input io.Writer will be processed with an io.MultiWriter call to create
-// a wrapper for multiple io.Writers
+// By default, these input io.Writer will be processed with a store.MultiWriter call to create
+// a wrapper for multiple io.Writers, which keeps writing to the remaining io.Writers if one fails
 func (l *logger) AddOuts(outs ...io.Writer) Logger {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -221,7 +312,7 @@ func (l *logger) AddOuts(outs ...io.Writer) Logger {
 
 	newouts = append(newouts, l.out)
 
-	l.out = io.MultiWriter(newouts...)
+	l.out = store.MultiWriter(newouts...)
 
 	return l
 }
@@ -332,30 +423,38 @@ func (l *nilLogger) AddOuts(outs ...io.Writer) Logger            { return l }
 func (l *nilLogger) Prefix(prefix string) Logger                 { return l }
 func (l *nilLogger) Sub(sub string) Logger                       { return l }
 func (l *nilLogger) Fields(fields map[string]interface{}) Logger { return l }
-func (l *nilLogger) IsSkipExit() bool                            { return true }
-func (l *nilLogger) Output(m *event.Event) (n int, err error)    { return 1, nil }
-func (l *nilLogger) Log(m ...*event.Event)                       {}
-func (l *nilLogger) Print(v ...interface{})                      {}
-func (l *nilLogger) Println(v ...interface{})                    {}
-func (l *nilLogger) Printf(format string, v ...interface{})      {}
-func (l *nilLogger) Panic(v ...interface{})                      {}
-func (l *nilLogger) Panicln(v ...interface{})                    {}
-func (l *nilLogger) Panicf(format string, v ...interface{})      {}
-func (l *nilLogger) Fatal(v ...interface{})                      {}
-func (l *nilLogger) Fatalln(v ...interface{})                    {}
-func (l *nilLogger) Fatalf(format string, v ...interface{})      {}
-func (l *nilLogger) Error(v ...interface{})                      {}
-func (l *nilLogger) Errorln(v ...interface{})                    {}
-func (l *nilLogger) Errorf(format string, v ...interface{})      {}
-func (l *nilLogger) Warn(v ...interface{})                       {}
-func (l *nilLogger) Warnln(v ...interface{})                     {}
-func (l *nilLogger) Warnf(format string, v ...interface{})       {}
-func (l *nilLogger) Info(v ...interface{})                       {}
-func (l *nilLogger) Infoln(v ...interface{})                     {}
-func (l *nilLogger) Infof(format string, v ...interface{})       {}
-func (l *nilLogger) Debug(v ...interface{})                      {}
-func (l *nilLogger) Debugln(v ...interface{})                    {}
-func (l *nilLogger) Debugf(format string, v ...interface{})      {}
-func (l *nilLogger) Trace(v ...interface{})                      {}
-func (l *nilLogger) Traceln(v ...interface{})                    {}
-func (l *nilLogger) Tracef(format string, v ...interface{})      {}
+func (l *nilLogger) With(fields map[string]interface{}) Logger   { return l }
+func (l *nilLogger) Child(prefix, sub string, fields map[string]interface{}) Logger {
+	return l
+}
+func (l *nilLogger) IsSkipExit() bool                                { return true }
+func (l *nilLogger) Sync() error                                     { return nil }
+func (l *nilLogger) Close() error                                    { return nil }
+func (l *nilLogger) Output(m *event.Event) (n int, err error)        { return 1, nil }
+func (l *nilLogger) Log(m ...*event.Event)                           {}
+func (l *nilLogger) Print(v ...interface{})                          {}
+func (l *nilLogger) Println(v ...interface{})                        {}
+func (l *nilLogger) Printf(format string, v ...interface{})          {}
+func (l *nilLogger) Panic(v ...interface{})                          {}
+func (l *nilLogger) Panicln(v ...interface{})                        {}
+func (l *nilLogger) Panicf(format string, v ...interface{})          {}
+func (l *nilLogger) Fatal(v ...interface{})                          {}
+func (l *nilLogger) Fatalln(v ...interface{})                        {}
+func (l *nilLogger) Fatalf(format string, v ...interface{})          {}
+func (l *nilLogger) Error(v ...interface{})                          {}
+func (l *nilLogger) Errorln(v ...interface{})                        {}
+func (l *nilLogger) Errorf(format string, v ...interface{})          {}
+func (l *nilLogger) Err(err error, v ...interface{})                 {}
+func (l *nilLogger) Errf(err error, format string, v ...interface{}) {}
+func (l *nilLogger) Warn(v ...interface{})                           {}
+func (l *nilLogger) Warnln(v ...interface{})                         {}
+func (l *nilLogger) Warnf(format string, v ...interface{})           {}
+func (l *nilLogger) Info(v ...interface{})                           {}
+func (l *nilLogger) Infoln(v ...interface{})                         {}
+func (l *nilLogger) Infof(format string, v ...interface{})           {}
+func (l *nilLogger) Debug(v ...interface{})                          {}
+func (l *nilLogger) Debugln(v ...interface{})                        {}
+func (l *nilLogger) Debugf(format string, v ...interface{})          {}
+func (l *nilLogger) Trace(v ...interface{})                          {}
+func (l *nilLogger) Traceln(v ...interface{})                        {}
+func (l *nilLogger) Tracef(format string, v ...interface{})          {}
@@ -272,7 +272,7 @@ func TestSetOuts(t *testing.T) {
 		}
 
 		if len(outs) > 0 {
-			return io.MultiWriter(outs...)
+			return store.MultiWriter(outs...)
 
 		}
 
@@ -331,27 +331,27 @@ func TestAddOuts(t *testing.T) {
 		{
 			name: "set a different writer",
 			w:    []io.Writer{bufs[0]},
-			out:  io.MultiWriter(bufs[0], defBuf),
+			out:  store.MultiWriter(bufs[0], defBuf),
 		},
 		{
 			name: "add multiple writers",
 			w:    []io.Writer{bufs[0], bufs[1], bufs[2]},
-			out:  io.MultiWriter(bufs[0], bufs[1], bufs[2], defBuf),
+			out:  store.MultiWriter(bufs[0], bufs[1], bufs[2], defBuf),
 		},
 		{
 			name: "add no writers",
 			w:    []io.Writer{},
-			out:  io.MultiWriter(defBuf),
+			out:  store.MultiWriter(defBuf),
 		},
 		{
 			name: "add nil writers",
 			w:    []io.Writer{nil, nil, nil},
-			out:  io.MultiWriter(defBuf),
+			out:  store.MultiWriter(defBuf),
 		},
 		{
 			name: "add a good writer mixed in nil writers",
 			w:    []io.Writer{nil, bufs[0], nil},
-			out:  io.MultiWriter(bufs[0], defBuf),
+			out:  store.MultiWriter(bufs[0], defBuf),
 		},
 	}
 
@@ -363,7 +363,7 @@ func TestAddOuts(t *testing.T) {
 		var outs []io.Writer
 
 		if len(test.w) == 0 {
-			return io.MultiWriter(defBuf)
+			return store.MultiWriter(defBuf)
 		} else if len(test.w) > 0 {
 			for _, w := range test.w {
 				if w != nil {
@@ -374,11 +374,11 @@ func TestAddOuts(t *testing.T) {
 
 		if len(outs) > 0 {
 			outs = append(outs, defBuf)
-			return io.MultiWriter(outs...)
+			return store.MultiWriter(outs...)
 
 		}
 
-		return io.MultiWriter(defBuf)
+		return store.MultiWriter(defBuf)
 	}
 
 	var verify = func(idx int, test test) {
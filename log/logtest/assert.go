@@ -0,0 +1,169 @@
+package logtest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/log/format/json"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EnvUpdateGolden is the environment variable which, when set to any non-empty value, makes
+// AssertGolden() (re)write its golden files instead of comparing against them:
+//
+//	ZLOG_UPDATE_GOLDEN=1 go test ./...
+const EnvUpdateGolden string = "ZLOG_UPDATE_GOLDEN"
+
+// goldenTime is the timestamp that all events are set to in a snapshot
+var goldenTime = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// AssertLen method fails the test if there aren't exactly `n` events
+func (e Events) AssertLen(t testing.TB, n int) {
+	t.Helper()
+
+	if len(e) != n {
+		t.Errorf("expected %v events; got %v:\n%s", n, len(e), e)
+	}
+}
+
+// AssertEmpty method fails the test if there are any events
+func (e Events) AssertEmpty(t testing.TB) {
+	t.Helper()
+
+	if len(e) != 0 {
+		t.Errorf("expected no events; got %v:\n%s", len(e), e)
+	}
+}
+
+// AssertNotEmpty method fails the test if there are no events
+func (e Events) AssertNotEmpty(t testing.TB) {
+	t.Helper()
+
+	if len(e) == 0 {
+		t.Errorf("expected at least one event; got none")
+	}
+}
+
+// AssertMessages method fails the test if the events' messages aren't the input ones, in order
+func (e Events) AssertMessages(t testing.TB, msgs ...string) {
+	t.Helper()
+
+	got := e.Messages()
+
+	if len(got) != len(msgs) {
+		t.Errorf("expected messages %q; got %q", msgs, got)
+		return
+	}
+
+	for idx := range msgs {
+		if got[idx] != msgs[idx] {
+			t.Errorf("expected messages %q; got %q", msgs, got)
+			return
+		}
+	}
+}
+
+// String method implements fmt.Stringer, listing the events one per line with their level,
+// prefix, sub-prefix and message; as printed by the failed assertions
+func (e Events) String() string {
+	sb := new(strings.Builder)
+
+	for idx, m := range e {
+		sb.WriteString(fmt.Sprintf("  #%v [%s] [%s] [%s] %q\n", idx, m.GetLevel().String(), m.GetPrefix(), m.GetSub(), m.GetMsg()))
+	}
+
+	return sb.String()
+}
+
+// Snapshot method encodes the events as JSON, one per line, with all timestamps set to the same
+// fixed time; so that the output is stable across test runs
+func (e Events) Snapshot() ([]byte, error) {
+	var (
+		buf = new(bytes.Buffer)
+		f   = &json.FmtJSON{}
+	)
+
+	for _, m := range e {
+		m = proto.Clone(m).(*event.Event)
+		m.Time = timestamppb.New(goldenTime)
+
+		b, err := f.Format(m)
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(b)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// AssertGolden method compares the events' Snapshot() with the contents of the golden file in the
+// input path, failing the test on the first line that differs.
+//
+// If the ZLOG_UPDATE_GOLDEN environment variable is set, the golden file (and its directory) is
+// written with the snapshot instead
+func (e Events) AssertGolden(t testing.TB, path string) {
+	t.Helper()
+
+	snapshot, err := e.Snapshot()
+
+	if err != nil {
+		t.Errorf("failed to encode the events: %v", err)
+		return
+	}
+
+	if os.Getenv(EnvUpdateGolden) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Errorf("failed to create the golden file's directory: %v", err)
+			return
+		}
+
+		if err := os.WriteFile(path, snapshot, 0o644); err != nil {
+			t.Errorf("failed to write the golden file: %v", err)
+		}
+
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Errorf("failed to read the golden file (set %s to create it): %v", EnvUpdateGolden, err)
+		return
+	}
+
+	if bytes.Equal(golden, snapshot) {
+		return
+	}
+
+	var (
+		wants = strings.Split(string(golden), "\n")
+		got   = strings.Split(string(snapshot), "\n")
+	)
+
+	for idx := 0; idx < len(wants) || idx < len(got); idx++ {
+		var w, g string
+
+		if idx < len(wants) {
+			w = wants[idx]
+		}
+
+		if idx < len(got) {
+			g = got[idx]
+		}
+
+		if w != g {
+			t.Errorf("events differ from the golden file %s at line %v:\n  wanted: %s\n  got:    %s", path, idx+1, w, g)
+			return
+		}
+	}
+}
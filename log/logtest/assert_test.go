@@ -0,0 +1,147 @@
+package logtest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testTB is a testing.TB which records its failures instead of failing the test
+type testTB struct {
+	testing.TB
+	errs []string
+}
+
+func (t *testTB) Helper() {}
+
+func (t *testTB) Errorf(format string, args ...interface{}) {
+	t.errs = append(t.errs, fmt.Sprintf(format, args...))
+}
+
+func TestAssert(t *testing.T) {
+	module := "Events"
+	funcname := "Assert*()"
+
+	type test struct {
+		name   string
+		assert func(testing.TB, Events)
+		fails  bool
+	}
+
+	var tests = []test{
+		{
+			name:   "matching length",
+			assert: func(tb testing.TB, e Events) { e.AssertLen(tb, 4) },
+		},
+		{
+			name:   "mismatched length",
+			assert: func(tb testing.TB, e Events) { e.Prefix("db").AssertLen(tb, 2) },
+			fails:  true,
+		},
+		{
+			name:   "empty query",
+			assert: func(tb testing.TB, e Events) { e.Prefix("grpc").AssertEmpty(tb) },
+		},
+		{
+			name:   "unexpected events",
+			assert: func(tb testing.TB, e Events) { e.AssertEmpty(tb) },
+			fails:  true,
+		},
+		{
+			name:   "not empty",
+			assert: func(tb testing.TB, e Events) { e.Sub("auth").AssertNotEmpty(tb) },
+		},
+		{
+			name:   "missing events",
+			assert: func(tb testing.TB, e Events) { e.Sub("cache").AssertNotEmpty(tb) },
+			fails:  true,
+		},
+		{
+			name: "matching messages",
+			assert: func(tb testing.TB, e Events) {
+				e.Prefix("http").Sub("router").AssertMessages(tb, "request received", "request failed")
+			},
+		},
+		{
+			name: "mismatched messages",
+			assert: func(tb testing.TB, e Events) {
+				e.Prefix("http").AssertMessages(tb, "request received", "request failed")
+			},
+			fails: true,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		tb := &testTB{TB: t}
+
+		test.assert(tb, testEvents())
+
+		if fails := len(tb.errs) > 0; fails != test.fails {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected a failure: %v ; got %q -- action: %s", idx, module, funcname, test.fails, tb.errs, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	module := "Events"
+	funcname := "AssertGolden()"
+
+	const golden = "testdata/events.golden"
+
+	// the events are built at different times, which the snapshot normalizes
+	testEvents().AssertGolden(t, golden)
+
+	// the remaining checks compare against the golden file, even when updating it
+	t.Setenv(EnvUpdateGolden, "")
+
+	tb := &testTB{TB: t}
+	testEvents()[1:].AssertGolden(tb, golden)
+
+	if len(tb.errs) != 1 || !strings.Contains(tb.errs[0], "at line 1") {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a mismatch on the first line; got %q -- action: %s", 1, module, funcname, tb.errs, "mismatched events")
+		return
+	}
+
+	tb = &testTB{TB: t}
+	testEvents().AssertGolden(tb, filepath.Join(t.TempDir(), "missing.golden"))
+
+	if len(tb.errs) != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a failure; got %q -- action: %s", 2, module, funcname, tb.errs, "missing golden file")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "golden file")
+}
+
+func TestAssertGoldenUpdate(t *testing.T) {
+	module := "Events"
+	funcname := "AssertGolden()"
+
+	path := filepath.Join(t.TempDir(), "new", "events.golden")
+
+	t.Setenv(EnvUpdateGolden, "1")
+	testEvents().AssertGolden(t, path)
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] failed to read the written golden file: %v -- action: %s", 0, module, funcname, err, "update golden file")
+		return
+	}
+
+	if lines := strings.Count(string(b), "\n"); lines != len(testEvents()) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v lines; got %v -- action: %s", 0, module, funcname, len(testEvents()), lines, "update golden file")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "update golden file")
+}
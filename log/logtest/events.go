@@ -0,0 +1,124 @@
+package logtest
+
+import (
+	"regexp"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"google.golang.org/protobuf/proto"
+)
+
+// Events type is a list of recorded events, with methods to query them. Each query returns a new
+// Events with the matching events, in order; so that queries can be chained:
+//
+//	events.Level(event.Level_warn, event.Level_error).Prefix("http").Message("timeout")
+type Events []*event.Event
+
+// Where method returns the events for which the input function returns true
+func (e Events) Where(fn func(m *event.Event) bool) Events {
+	var out Events
+
+	for _, m := range e {
+		if fn(m) {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
+
+// Level method returns the events of any of the input levels
+func (e Events) Level(levels ...event.Level) Events {
+	return e.Where(func(m *event.Event) bool {
+		for _, level := range levels {
+			if m.GetLevel() == level {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// MinLevel method returns the events of level `level` and above
+func (e Events) MinLevel(level event.Level) Events {
+	return e.Where(func(m *event.Event) bool {
+		return m.GetLevel().Int() >= level.Int()
+	})
+}
+
+// Prefix method returns the events with the input prefix
+func (e Events) Prefix(prefix string) Events {
+	return e.Where(func(m *event.Event) bool {
+		return m.GetPrefix() == prefix
+	})
+}
+
+// Sub method returns the events with the input sub-prefix
+func (e Events) Sub(sub string) Events {
+	return e.Where(func(m *event.Event) bool {
+		return m.GetSub() == sub
+	})
+}
+
+// Message method returns the events whose message matches the input regular expression. It
+// panics if the expression is invalid, as with regexp.MustCompile()
+func (e Events) Message(pattern string) Events {
+	re := regexp.MustCompile(pattern)
+
+	return e.Where(func(m *event.Event) bool {
+		return re.MatchString(m.GetMsg())
+	})
+}
+
+// Fields method returns the events whose metadata contains all of the input fields, with equal
+// values (after converting them as the Logger does, so that an int matches the same number)
+func (e Events) Fields(fields map[string]interface{}) Events {
+	return e.Where(func(m *event.Event) bool {
+		meta := m.GetMeta().GetFields()
+
+		for k, v := range fields {
+			got, ok := meta[k]
+
+			if !ok {
+				return false
+			}
+
+			value, err := event.ToValue(v)
+
+			if err != nil || !proto.Equal(got, value) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// First method returns the first event, or nil if there are none
+func (e Events) First() *event.Event {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e[0]
+}
+
+// Last method returns the last event, or nil if there are none
+func (e Events) Last() *event.Event {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e[len(e)-1]
+}
+
+// Messages method returns the messages of the events, in order
+func (e Events) Messages() []string {
+	var msgs = make([]string, 0, len(e))
+
+	for _, m := range e {
+		msgs = append(msgs, m.GetMsg())
+	}
+
+	return msgs
+}
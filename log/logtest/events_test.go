@@ -0,0 +1,107 @@
+package logtest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func testEvents() Events {
+	return Events{
+		event.New().Level(event.Level_info).Prefix("http").Sub("router").Message("request received").Metadata(map[string]interface{}{"status": 200, "path": "/"}).Build(),
+		event.New().Level(event.Level_warn).Prefix("http").Sub("auth").Message("token expired").Metadata(map[string]interface{}{"user": "alice"}).Build(),
+		event.New().Level(event.Level_error).Prefix("db").Sub("query").Message("query timed out").Metadata(map[string]interface{}{"table": "users", "retries": 3}).Build(),
+		event.New().Level(event.Level_error).Prefix("http").Sub("router").Message("request failed").Metadata(map[string]interface{}{"status": 500, "path": "/"}).Build(),
+	}
+}
+
+func TestEventsQueries(t *testing.T) {
+	module := "Events"
+	funcname := "queries"
+
+	type test struct {
+		name  string
+		query func(Events) Events
+		wants []string
+	}
+
+	var tests = []test{
+		{
+			name:  "by level",
+			query: func(e Events) Events { return e.Level(event.Level_warn, event.Level_error) },
+			wants: []string{"token expired", "query timed out", "request failed"},
+		},
+		{
+			name:  "by minimum level",
+			query: func(e Events) Events { return e.MinLevel(event.Level_error) },
+			wants: []string{"query timed out", "request failed"},
+		},
+		{
+			name:  "by prefix and sub-prefix",
+			query: func(e Events) Events { return e.Prefix("http").Sub("router") },
+			wants: []string{"request received", "request failed"},
+		},
+		{
+			name:  "by message regexp",
+			query: func(e Events) Events { return e.Message("^request (received|failed)$") },
+			wants: []string{"request received", "request failed"},
+		},
+		{
+			name:  "by metadata subset",
+			query: func(e Events) Events { return e.Fields(map[string]interface{}{"status": 500, "path": "/"}) },
+			wants: []string{"request failed"},
+		},
+		{
+			name:  "by metadata with mismatched type",
+			query: func(e Events) Events { return e.Fields(map[string]interface{}{"retries": "3"}) },
+		},
+		{
+			name:  "by missing metadata key",
+			query: func(e Events) Events { return e.Fields(map[string]interface{}{"missing": true}) },
+		},
+		{
+			name: "by custom function",
+			query: func(e Events) Events {
+				return e.Where(func(m *event.Event) bool { return len(m.GetMsg()) > 14 })
+			},
+			wants: []string{"request received", "query timed out"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		got := test.query(testEvents())
+
+		if len(got) != len(test.wants) || (len(got) > 0 && !reflect.DeepEqual(got.Messages(), test.wants)) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %q ; got %q -- action: %s", idx, module, funcname, test.wants, got.Messages(), test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestEventsFirstLast(t *testing.T) {
+	module := "Events"
+	funcname := "First() / Last()"
+
+	var empty Events
+
+	if empty.First() != nil || empty.Last() != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected nil events -- action: %s", 0, module, funcname, "no events")
+		return
+	}
+
+	events := testEvents()
+
+	if events.First().GetMsg() != "request received" || events.Last().GetMsg() != "request failed" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected events: %v ; %v -- action: %s", 1, module, funcname, events.First(), events.Last(), "list of events")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "list of events")
+}
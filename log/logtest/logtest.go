@@ -0,0 +1,172 @@
+// Package logtest provides a Logger that records the events written to it in memory, so that
+// tests can query and assert on the structured events (by level, prefix, sub-prefix, message
+// and metadata) instead of parsing formatted text out of a buffer:
+//
+//	func TestHandler(t *testing.T) {
+//	    logger := logtest.New()
+//
+//	    handle(logger, req)
+//
+//	    logger.Events().Level(event.Level_error).Message("^failed to").AssertLen(t, 1)
+//	    logger.Events().Fields(map[string]interface{}{"status": 500}).AssertNotEmpty(t)
+//	}
+//
+// A Recorder can also be added to an existing Logger as a Sink, with Recorder.Sink(); and as the
+// Logger is a log.Logger, it can be used as the backend of a gRPC Log Server (server.WithLogger()),
+// so that gRPC Log Client tests can assert on the events the server received.
+//
+// Recorded events can be compared against golden files with Events.AssertGolden(), which
+// normalizes their timestamps
+package logtest
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+	"google.golang.org/protobuf/proto"
+)
+
+// Recorder struct is an io.Writer that keeps the (protobuf-encoded) events written to it as
+// structured event.Events, which can be queried with its Events() method.
+//
+// Use it as an output for a Logger with its Sink() method, or as the Logger returned by New()
+type Recorder struct {
+	mu      sync.Mutex
+	events  []*event.Event
+	changed chan struct{}
+}
+
+// NewRecorder function creates an empty Recorder
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Sink method returns a LoggerConfig that adds the Recorder as a Sink of a Logger, recording
+// the events of level `level` and above
+func (r *Recorder) Sink(level event.Level) log.LoggerConfig {
+	return log.WithSink(r, log.FormatProtobuf, level)
+}
+
+// Write method implements the io.Writer interface, decoding the input protobuf-encoded event.Event
+// and recording it. It returns an error if the input buffer is not a valid event.Event
+func (r *Recorder) Write(p []byte) (n int, err error) {
+	m, err := event.Decode(p)
+
+	if err != nil {
+		return 0, err
+	}
+
+	r.Record(m)
+
+	return len(p), nil
+}
+
+// Record method will add (a copy of) the input event.Event to the Recorder
+func (r *Recorder) Record(m *event.Event) {
+	if m == nil {
+		return
+	}
+
+	m = proto.Clone(m).(*event.Event)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, m)
+
+	// wake up any callers waiting for new events
+	if r.changed != nil {
+		close(r.changed)
+		r.changed = nil
+	}
+}
+
+// Events method returns the events recorded so far, in order
+func (r *Recorder) Events() Events {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := make(Events, len(r.events))
+	copy(events, r.events)
+
+	return events
+}
+
+// Len method returns the number of events recorded so far
+func (r *Recorder) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.events)
+}
+
+// Reset method clears the recorded events
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = nil
+}
+
+// Wait method blocks until the Recorder holds at least `n` events, or until the input timeout
+// expires. It returns the recorded events, and false if it timed out -- which is useful when the
+// events are written asynchronously, such as by a gRPC Log Server
+func (r *Recorder) Wait(n int, timeout time.Duration) (Events, bool) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		r.mu.Lock()
+
+		if r.changed == nil {
+			r.changed = make(chan struct{})
+		}
+
+		count, changed := len(r.events), r.changed
+		r.mu.Unlock()
+
+		if count >= n {
+			return r.Events(), true
+		}
+
+		select {
+		case <-changed:
+		case <-timer.C:
+			return r.Events(), false
+		}
+	}
+}
+
+// Logger struct is a log.Logger which records all of its events (of any level) in a Recorder,
+// while discarding their formatted output
+type Logger struct {
+	log.Logger
+	*Recorder
+}
+
+// New function creates a Logger that records its events, configured with the input LoggerConfigs
+// on top of its defaults: discarding its output and skipping exit calls (so that fatal and panic
+// events can be tested). The recording Sink is always added, regardless of the input LoggerConfigs
+func New(confs ...log.LoggerConfig) *Logger {
+	r := NewRecorder()
+
+	defaults := []log.LoggerConfig{
+		log.WithOut(io.Discard),
+		log.SkipExit,
+	}
+
+	return &Logger{
+		Logger:   log.New(append(append(defaults, confs...), r.Sink(event.Level_trace))...),
+		Recorder: r,
+	}
+}
+
+// Write method implements the io.Writer interface, writing the input buffer to the Logger
+// (see log.Logger's Write() method); as opposed to the Recorder's Write() method, which only
+// takes in protobuf-encoded events
+func (l *Logger) Write(p []byte) (n int, err error) {
+	return l.Logger.Write(p)
+}
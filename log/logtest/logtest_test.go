@@ -0,0 +1,173 @@
+package logtest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/grpc/client"
+	"github.com/zalgonoise/zlog/grpc/server"
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+const maxWaitTime time.Duration = time.Millisecond * 50
+
+func TestRecorderWrite(t *testing.T) {
+	module := "Recorder"
+	funcname := "Write()"
+
+	type test struct {
+		name  string
+		input []byte
+		ok    bool
+	}
+
+	valid := event.New().Level(event.Level_warn).Message("recorded").Build().Encode()
+
+	var tests = []test{
+		{
+			name:  "protobuf-encoded event",
+			input: valid,
+			ok:    true,
+		},
+		{
+			name:  "invalid buffer",
+			input: []byte("not an event"),
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		r := NewRecorder()
+
+		n, err := r.Write(test.input)
+
+		if !test.ok {
+			if err == nil || r.Len() != 0 {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] expected an error and no events; got %v and %v events -- action: %s", idx, module, funcname, err, r.Len(), test.name)
+				return
+			}
+
+			t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+			return
+		}
+
+		if err != nil || n != len(test.input) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected result: %v bytes written ; error: %v -- action: %s", idx, module, funcname, n, err, test.name)
+			return
+		}
+
+		if m := r.Events().First(); m.GetMsg() != "recorded" || m.GetLevel() != event.Level_warn {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected event recorded: %v -- action: %s", idx, module, funcname, m, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestRecorderWait(t *testing.T) {
+	module := "Recorder"
+	funcname := "Wait()"
+
+	r := NewRecorder()
+	r.Record(nil)
+
+	if events, ok := r.Wait(1, maxWaitTime); ok || len(events) != 0 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a timeout with no events; got %v events -- action: %s", 0, module, funcname, len(events), "timeout")
+		return
+	}
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			time.Sleep(time.Millisecond)
+			r.Record(event.New().Message("async").Build())
+		}
+	}()
+
+	if events, ok := r.Wait(3, time.Second); !ok || len(events) != 3 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected 3 events; got %v (ok: %v) -- action: %s", 1, module, funcname, len(events), ok, "asynchronous events")
+		return
+	}
+
+	r.Reset()
+
+	if r.Len() != 0 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected no events after a reset; got %v -- action: %s", 2, module, funcname, r.Len(), "reset")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "asynchronous events")
+}
+
+func TestNew(t *testing.T) {
+	module := "Logger"
+	funcname := "New()"
+
+	logger := New(log.WithPrefix("svc"), log.WithFilter(event.Level_debug))
+
+	logger.Trace("filtered out")
+	logger.Debug("starting")
+	logger.Child("", "db", map[string]interface{}{"table": "users"}).Errf(errors.New("timeout"), "query failed")
+	logger.Fatal("exit is skipped")
+
+	events := logger.Events()
+
+	if wants := []string{"starting", "query failed", "exit is skipped"}; len(events) != len(wants) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected messages %q; got %q -- action: %s", 0, module, funcname, wants, events.Messages(), "record events")
+		return
+	}
+
+	if m := events.Sub("db").First(); m.GetPrefix() != "svc" || m.GetError().GetMessage() != "timeout" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected child event: %v -- action: %s", 1, module, funcname, m, "child logger")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "record events")
+}
+
+func TestServerBackend(t *testing.T) {
+	module := "Logger"
+	funcname := "server.WithLogger()"
+
+	const addr = "127.0.0.1:45101"
+
+	logger := New()
+
+	svr := server.New(
+		server.WithAddr(addr),
+		server.WithLogger(logger),
+		server.WithServiceLogger(log.New(log.NilConfig)),
+	)
+
+	go svr.Serve()
+	defer svr.Stop()
+
+	// sleep to allow server to start up
+	time.Sleep(maxWaitTime)
+
+	c, _ := client.New(
+		client.WithAddr(addr),
+		client.WithLogger(log.New(log.NilConfig)),
+		client.UnaryRPC(),
+	)
+	defer c.Close()
+
+	c.Prefix("client").Fields(map[string]interface{}{"req": 1})
+	c.Warn("sent over gRPC")
+
+	events, ok := logger.Wait(1, 5*time.Second)
+
+	if !ok {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] no events received by the server -- action: %s", 0, module, funcname, "client event")
+		return
+	}
+
+	events.Level(event.Level_warn).Prefix("client").Fields(map[string]interface{}{"req": 1}).AssertMessages(t, "sent over gRPC")
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "client event")
+}
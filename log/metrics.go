@@ -0,0 +1,185 @@
+package log
+
+import (
+	"sync/atomic"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// Counter struct is a single value reported by a Collector, named and labeled as a Prometheus
+// counter (e.g. `zlog_events_total{level="info"}`)
+type Counter struct {
+	Name   string
+	Help   string
+	Labels map[string]string
+	Value  uint64
+}
+
+// Collector interface describes a type which reports its own metrics as a list of Counters, such
+// as Loggers created with New(), or the gRPC Log Server and Client
+type Collector interface {
+	Collect() []Counter
+}
+
+// Metrics struct contains the counters of a Logger, since it was created:
+//   - Events: the events written to its outputs, by level name
+//   - Bytes: the bytes written to its outputs (including its Sinks)
+//   - FormatErrors: the failed attempts to format an event
+//   - WriteErrors: the failed writes to its outputs (including its Sinks)
+//   - Filtered: the events skipped by its level filter, or vetoed by its hooks
+//   - Sampled: the events dropped by its sampler or deduper
+//   - Dropped: the events lost after the Logger is closed, or on its full asynchronous queue
+type Metrics struct {
+	Events       map[string]uint64
+	Bytes        uint64
+	FormatErrors uint64
+	WriteErrors  uint64
+	Filtered     uint64
+	Sampled      uint64
+	Dropped      uint64
+}
+
+// MetricsLogger interface describes a Logger that keeps track of the events it writes, filters
+// and fails to write; which are also exposed as Counters for a metrics handler.
+//
+// Loggers created with New() implement this interface, sharing their counters with their children
+type MetricsLogger interface {
+	Logger
+	Collector
+
+	Metrics() Metrics
+}
+
+// levels lists the event.Levels reported in a Logger's Metrics, in order
+var levels = []event.Level{
+	event.Level_trace,
+	event.Level_debug,
+	event.Level_info,
+	event.Level_warn,
+	event.Level_error,
+	event.Level_fatal,
+	event.Level_panic,
+}
+
+// counters struct holds a Logger's metrics, updated atomically. It is placed as the first field
+// of the logger struct, to keep its values 64-bit aligned
+type counters struct {
+	events       [10]uint64
+	bytes        uint64
+	formatErrors uint64
+	writeErrors  uint64
+	filtered     uint64
+	sampled      uint64
+	dropped      uint64
+}
+
+// event method will register a written event of the input level, and the bytes written
+func (c *counters) event(level event.Level, n int) {
+	if idx := level.Int(); idx >= 0 && int(idx) < len(c.events) {
+		atomic.AddUint64(&c.events[idx], 1)
+	}
+
+	c.written(n)
+}
+
+// written method will register the bytes written to one of the Logger's outputs
+func (c *counters) written(n int) {
+	if n > 0 {
+		atomic.AddUint64(&c.bytes, uint64(n))
+	}
+}
+
+// Metrics method returns the Logger's counters. A child Logger returns the counters of its root
+// Logger, as they are shared
+func (l *logger) Metrics() Metrics {
+	root := l.root()
+	c := &root.counters
+
+	m := Metrics{
+		Events:       make(map[string]uint64, len(levels)),
+		Bytes:        atomic.LoadUint64(&c.bytes),
+		FormatErrors: atomic.LoadUint64(&c.formatErrors),
+		WriteErrors:  atomic.LoadUint64(&c.writeErrors),
+		Filtered:     atomic.LoadUint64(&c.filtered),
+		Sampled:      atomic.LoadUint64(&c.sampled),
+		Dropped:      atomic.LoadUint64(&c.dropped),
+	}
+
+	for _, level := range levels {
+		m.Events[level.String()] = atomic.LoadUint64(&c.events[level.Int()])
+	}
+
+	if root.async != nil {
+		m.Dropped += root.async.stats().Dropped
+	}
+
+	return m
+}
+
+// Collect method implements the Collector interface, returning the Logger's Metrics as Counters
+func (l *logger) Collect() []Counter {
+	return l.Metrics().Counters()
+}
+
+// Metrics method returns the sum of the counters of all of the multiLogger's Loggers which
+// implement MetricsLogger
+func (l *multiLogger) Metrics() Metrics {
+	m := Metrics{
+		Events: make(map[string]uint64, len(levels)),
+	}
+
+	for _, level := range levels {
+		m.Events[level.String()] = 0
+	}
+
+	for _, logger := range l.loggers {
+		ml, ok := logger.(MetricsLogger)
+		if !ok {
+			continue
+		}
+
+		lm := ml.Metrics()
+
+		for level, n := range lm.Events {
+			m.Events[level] += n
+		}
+
+		m.Bytes += lm.Bytes
+		m.FormatErrors += lm.FormatErrors
+		m.WriteErrors += lm.WriteErrors
+		m.Filtered += lm.Filtered
+		m.Sampled += lm.Sampled
+		m.Dropped += lm.Dropped
+	}
+
+	return m
+}
+
+// Collect method implements the Collector interface, returning the sum of the multiLogger's
+// Metrics as Counters
+func (l *multiLogger) Collect() []Counter {
+	return l.Metrics().Counters()
+}
+
+// Counters method converts the Metrics into a list of Counters, to be exposed by a metrics handler
+func (m Metrics) Counters() []Counter {
+	var c = make([]Counter, 0, len(levels)+6)
+
+	for _, level := range levels {
+		c = append(c, Counter{
+			Name:   "zlog_events_total",
+			Help:   "Events written by the logger, by level.",
+			Labels: map[string]string{"level": level.String()},
+			Value:  m.Events[level.String()],
+		})
+	}
+
+	return append(c,
+		Counter{Name: "zlog_written_bytes_total", Help: "Bytes written by the logger to its outputs.", Value: m.Bytes},
+		Counter{Name: "zlog_format_errors_total", Help: "Events the logger failed to format.", Value: m.FormatErrors},
+		Counter{Name: "zlog_write_errors_total", Help: "Failed writes to the logger's outputs.", Value: m.WriteErrors},
+		Counter{Name: "zlog_filtered_events_total", Help: "Events skipped by the logger's level filter or hooks.", Value: m.Filtered},
+		Counter{Name: "zlog_sampled_events_total", Help: "Events dropped by the logger's sampler or deduper.", Value: m.Sampled},
+		Counter{Name: "zlog_dropped_events_total", Help: "Events lost by a closed logger or a full asynchronous queue.", Value: m.Dropped},
+	)
+}
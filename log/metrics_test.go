@@ -0,0 +1,152 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/log/format/text"
+)
+
+type testFailingWriter struct{}
+
+func (testFailingWriter) Write(p []byte) (n int, err error) {
+	return 0, errors.New("failed to write")
+}
+
+type testFailingFormatter struct{}
+
+func (testFailingFormatter) Format(m *event.Event) ([]byte, error) {
+	return nil, errors.New("failed to format")
+}
+
+func (f testFailingFormatter) Apply(lb *LoggerBuilder) {
+	lb.Fmt = f
+}
+
+func TestMetrics(t *testing.T) {
+	module := "Logger"
+	funcname := "Metrics()"
+
+	type test struct {
+		name  string
+		confs []LoggerConfig
+		log   func(l Logger)
+		wants Metrics
+	}
+
+	var events = func(info, warn uint64) map[string]uint64 {
+		return map[string]uint64{"trace": 0, "debug": 0, "info": info, "warn": warn, "error": 0, "fatal": 0, "panic": 0}
+	}
+
+	var tests = []test{
+		{
+			name: "events by level",
+			log: func(l Logger) {
+				l.Info("a")
+				l.Child("child", "", nil).Warn("b")
+			},
+			wants: Metrics{Events: events(1, 1), Bytes: 4},
+		},
+		{
+			name:  "level filter and hooks",
+			confs: []LoggerConfig{WithFilter(event.Level_warn), WithHook(func(m *event.Event) bool { return m.GetMsg() != "vetoed" })},
+			log: func(l Logger) {
+				l.Info("a")
+				l.Warn("vetoed")
+				l.Warn("b")
+			},
+			wants: Metrics{Events: events(0, 1), Bytes: 2, Filtered: 2},
+		},
+		{
+			name:  "sampler and deduper",
+			confs: []LoggerConfig{WithSampler(NewSampler(time.Hour, 2, 0)), WithDedup(time.Hour)},
+			log: func(l Logger) {
+				l.Info("a")
+				l.Info("a")
+				l.Info("b")
+				l.Info("c")
+			},
+			wants: Metrics{Events: events(2, 0), Bytes: 4, Sampled: 2},
+		},
+		{
+			name:  "write errors",
+			confs: []LoggerConfig{WithSink(testFailingWriter{}, nil, event.Level_trace)},
+			log:   func(l Logger) { l.Info("a") },
+			wants: Metrics{Events: events(1, 0), Bytes: 2, WriteErrors: 1},
+		},
+		{
+			name:  "failed writes are not counted as events",
+			confs: []LoggerConfig{WithOut(testFailingWriter{})},
+			log:   func(l Logger) { l.Info("a") },
+			wants: Metrics{Events: events(0, 0), WriteErrors: 1},
+		},
+		{
+			name:  "format errors",
+			confs: []LoggerConfig{testFailingFormatter{}},
+			log:   func(l Logger) { l.Info("a") },
+			wants: Metrics{Events: events(0, 0), FormatErrors: 1},
+		},
+		{
+			name: "writes after closing",
+			log: func(l Logger) {
+				_ = l.Close()
+				l.Info("a")
+			},
+			wants: Metrics{Events: events(0, 0), Dropped: 1},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		logger := New(append([]LoggerConfig{
+			WithOut(new(bytes.Buffer)),
+			WithFormat(text.New().NoHeaders().NoTimestamp().NoLevel().Build()),
+		}, test.confs...)...)
+
+		test.log(logger)
+
+		m := logger.(MetricsLogger).Metrics()
+
+		if !reflect.DeepEqual(m, test.wants) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %+v ; got %+v -- action: %s", idx, module, funcname, test.wants, m, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestMultiLoggerMetrics(t *testing.T) {
+	module := "MultiLogger"
+	funcname := "Collect()"
+
+	logger := MultiLogger(
+		New(WithOut(new(bytes.Buffer))),
+		New(WithOut(new(bytes.Buffer)), WithFilter(event.Level_error)),
+	)
+
+	logger.Info("null")
+
+	m := logger.(MetricsLogger).Metrics()
+
+	if m.Events["info"] != 1 || m.Filtered != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected metrics: %+v -- action: %s", 0, module, funcname, m, "sum metrics")
+		return
+	}
+
+	for _, c := range logger.(Collector).Collect() {
+		if c.Name == "zlog_filtered_events_total" && c.Value != 1 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected counter: %+v -- action: %s", 1, module, funcname, c, "collect counters")
+			return
+		}
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "sum metrics")
+}
@@ -2,7 +2,7 @@ package log
 
 import (
 	"fmt"
-	"os"
+	"sync/atomic"
 
 	"github.com/zalgonoise/zlog/log/event"
 )
@@ -35,6 +35,9 @@ type Printer interface {
 	Errorln(v ...interface{})
 	Errorf(format string, v ...interface{})
 
+	Err(err error, v ...interface{})
+	Errf(err error, format string, v ...interface{})
+
 	Warn(v ...interface{})
 	Warnln(v ...interface{})
 	Warnf(format string, v ...interface{})
@@ -66,15 +69,25 @@ func (l *logger) checkDefaults(m *event.Event) {
 	}
 
 	// push logger metadata to message
-	if m.Meta == nil && len(m.Meta.AsMap()) == 0 && len(l.meta) > 0 {
-		m.Meta = event.Field(l.meta).Encode()
-	} else if m.Meta != nil && len(m.Meta.AsMap()) > 0 && len(l.meta) > 0 {
-		// add Logger metadata to existing metadata
-		mcopy := m.Meta.AsMap()
+	if m.Meta == nil && len(l.meta) > 0 {
+		m.SetMetadata(l.meta)
+	} else if m.Meta != nil && len(m.Meta.GetFields()) > 0 && len(l.meta) > 0 {
+		// add Logger metadata to existing metadata, converting only the Logger's values
 		for k, v := range l.meta {
-			mcopy[k] = v
+			if value, err := event.ToValue(v); err == nil {
+				m.Meta.Fields[k] = value
+			}
+
+			// keep the typed data in sync with the metadata
+			if data, ok := event.NewValue(v); ok {
+				if m.Data == nil {
+					m.Data = map[string]*event.Value{}
+				}
+				m.Data[k] = data
+			} else {
+				delete(m.Data, k)
+			}
 		}
-		m.Meta = event.Field(mcopy).Encode()
 	}
 }
 
@@ -86,27 +99,169 @@ func (l *logger) checkDefaults(m *event.Event) {
 // All printing messages are either applying a `Logger.Log()` action or a `Logger.Output` one; while the former
 // is simply calling the latter.
 func (l *logger) Output(m *event.Event) (n int, err error) {
+	if l.isClosed() {
+		atomic.AddUint64(&l.root().counters.dropped, 1)
+		l.handleError(ErrClosed, m, nil)
+		return 0, ErrClosed
+	}
 
-	if m.Level != nil && l.levelFilter > m.Level.Int() {
+	if m.Level != nil && atomic.LoadInt32(&l.root().levelFilter) > m.Level.Int() {
+		atomic.AddUint64(&l.root().counters.filtered, 1)
 		return 0, nil
 	}
 
+	if !l.prepare(m) {
+		return 0, nil
+	}
+
+	if l.async != nil {
+		// fatal and panic events are written synchronously, after flushing the queue
+		if m.GetLevel().Int() < event.Level_fatal.Int() {
+			n, err = l.async.push(m)
+
+			if err != nil {
+				l.handleError(err, m, nil)
+			}
+
+			return n, err
+		}
+
+		ctx, cancel := l.exitContext()
+		_ = l.async.flush(ctx) // deliberately ignore error in this method call
+		cancel()
+	}
+
+	return l.write(m)
+}
+
+// prepare method will apply the Logger's defaults to the input event.Event, and run it through
+// its redactor, hooks, deduper and sampler. It returns false if the event.Event should be dropped
+func (l *logger) prepare(m *event.Event) bool {
+	// capture the caller before taking the lock
+	if l.caller && m.Caller == nil {
+		m.Caller = l.callerFrame()
+	}
+
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
 	l.checkDefaults(m)
 
-	// format message
-	buf, err := l.fmt.Format(m)
+	// scrub sensitive data
+	if l.redactor != nil {
+		l.redactor.Redact(m)
+	}
+
+	c := &l.root().counters
+
+	// run hooks; drop vetoed events
+	if !l.hooks.Fire(m) {
+		atomic.AddUint64(&c.filtered, 1)
+		return false
+	}
+
+	// suppress repeated events
+	if l.dedup != nil && !l.dedup.Dedup(m) {
+		atomic.AddUint64(&c.sampled, 1)
+		return false
+	}
+
+	// drop sampled-out events
+	if l.sampler != nil && !l.sampler.Sample(m) {
+		atomic.AddUint64(&c.sampled, 1)
+		return false
+	}
+
+	return true
+}
+
+// output method will apply defaults to the input event.Event and write it, skipping any
+// filtering or sampling. It is used to write the Logger's own (summary) events, which are
+// dropped once the Logger's outputs are closed
+func (l *logger) output(m *event.Event) (n int, err error) {
+	if l.isDone() {
+		atomic.AddUint64(&l.root().counters.dropped, 1)
+		l.handleError(ErrClosed, m, nil)
+		return 0, ErrClosed
+	}
+
+	l.mu.Lock()
+	l.checkDefaults(m)
+
+	if l.redactor != nil {
+		l.redactor.Redact(m)
+	}
+
+	ok := l.hooks.Fire(m)
+	l.mu.Unlock()
+
+	if !ok {
+		return 0, nil
+	}
+
+	return l.write(m)
+}
+
+// write method will format the input event.Event and write it to the Logger's io.Writer and Sinks,
+// passing any error to the Logger's error handler and fallback io.Writer (once the write lock is
+// released)
+func (l *logger) write(m *event.Event) (n int, err error) {
+	n, buf, err := l.writeOuts(m)
 
 	if err != nil {
-		return -1, err
+		l.handleError(err, m, buf)
 	}
 
-	l.buf = buf
+	return n, err
+}
+
+// writeOuts method will format the input event.Event and write it to the Logger's io.Writer and
+// Sinks, returning the formatted buffer alongside the write's results. If the Logger's LogFormatter
+// fails, the event is still written to the Sinks with their own LogFormatter, and the format error
+// is returned.
+//
+// Writes are serialized with their own lock, so that a slow io.Writer does not block callers
+// that are only applying the Logger's defaults (e.g. while its asynchronous queue is draining).
+// Child Loggers take their root Logger's write lock, as they share its io.Writer
+func (l *logger) writeOuts(m *event.Event) (n int, buf []byte, err error) {
+	l.mu.Lock()
+	out, f, sinks := l.out, l.fmt, l.sinks
+	l.mu.Unlock()
+
+	root := l.root()
+
+	wmu := &root.wmu
+	wmu.Lock()
+	defer wmu.Unlock()
+
+	// format message
+	buf, ferr := f.Format(m)
+
+	if ferr != nil {
+		// skip the Logger's io.Writer, but still write to the Sinks with their own LogFormatter
+		atomic.AddUint64(&root.counters.formatErrors, 1)
+		n, buf, err = -1, nil, ferr
+	} else {
+		l.buf = buf
 
-	// write message to outs
-	return l.out.Write(l.buf)
+		// write message to outs
+		n, err = out.Write(l.buf)
+
+		if err != nil {
+			atomic.AddUint64(&root.counters.writeErrors, 1)
+		} else {
+			root.counters.event(m.GetLevel(), n)
+		}
+	}
+
+	// write message to sinks
+	if len(sinks) > 0 {
+		if serr := l.writeSinks(m, sinks, f, buf, ferr); serr != nil && err == nil {
+			err = serr
+		}
+	}
+
+	return n, buf, err
 }
 
 // Print method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
@@ -164,9 +319,9 @@ func (l *logger) Log(m ...*event.Event) {
 		s := msg.GetMsg()
 		_, _ = l.Output(msg) // deliberately ignore error in this method call
 		if !l.IsSkipExit() && *msg.Level == event.Level_panic {
-			panic(s)
+			l.panicWith(s)
 		} else if !l.IsSkipExit() && *msg.Level == event.Level_fatal {
-			os.Exit(1)
+			l.exitWith(1)
 		}
 	}
 
@@ -186,7 +341,7 @@ func (l *logger) Panic(v ...interface{}) {
 	_, _ = l.Output(log) // deliberately ignore error in this method call
 
 	if !l.IsSkipExit() {
-		panic(log.GetMsg())
+		l.panicWith(log.GetMsg())
 	}
 }
 
@@ -204,7 +359,7 @@ func (l *logger) Panicln(v ...interface{}) {
 	_, _ = l.Output(log) // deliberately ignore error in this method call
 
 	if !l.IsSkipExit() {
-		panic(log.GetMsg())
+		l.panicWith(log.GetMsg())
 	}
 
 }
@@ -223,7 +378,7 @@ func (l *logger) Panicf(format string, v ...interface{}) {
 	_, _ = l.Output(log) // deliberately ignore error in this method call
 
 	if !l.IsSkipExit() {
-		panic(log.GetMsg())
+		l.panicWith(log.GetMsg())
 	}
 
 }
@@ -241,7 +396,7 @@ func (l *logger) Fatal(v ...interface{}) {
 	_, _ = l.Output(log) // deliberately ignore error in this method call
 
 	if !l.IsSkipExit() {
-		os.Exit(1)
+		l.exitWith(1)
 	}
 }
 
@@ -258,7 +413,7 @@ func (l *logger) Fatalln(v ...interface{}) {
 	_, _ = l.Output(log) // deliberately ignore error in this method call
 
 	if !l.IsSkipExit() {
-		os.Exit(1)
+		l.exitWith(1)
 	}
 }
 
@@ -275,7 +430,7 @@ func (l *logger) Fatalf(format string, v ...interface{}) {
 	_, _ = l.Output(log) // deliberately ignore error in this method call
 
 	if !l.IsSkipExit() {
-		os.Exit(1)
+		l.exitWith(1)
 	}
 }
 
@@ -312,6 +467,30 @@ func (l *logger) Errorf(format string, v ...interface{}) {
 	_, _ = l.Output(log) // deliberately ignore error in this method call
 }
 
+// Err method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern, while
+// automatically applying LogLevel Error and recording the input error in the event.
+//
+// If no message is provided, the error's message is used instead
+func (l *logger) Err(err error, v ...interface{}) {
+	// build message
+	log := event.New().Level(event.Level_error).Prefix(l.prefix).Message(
+		fmt.Sprint(v...),
+	).Err(err).Metadata(l.meta).Build()
+
+	_, _ = l.Output(log) // deliberately ignore error in this method call
+}
+
+// Errf method (similar to fmt.Printf) will print a message using an fmt.Sprintf(format, v...) pattern,
+// while automatically applying LogLevel Error and recording the input error in the event.
+func (l *logger) Errf(err error, format string, v ...interface{}) {
+	// build message
+	log := event.New().Level(event.Level_error).Prefix(l.prefix).Message(
+		fmt.Sprintf(format, v...),
+	).Err(err).Metadata(l.meta).Build()
+
+	_, _ = l.Output(log) // deliberately ignore error in this method call
+}
+
 // Warn method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern, while
 // automatically applying LogLevel Warn.
 func (l *logger) Warn(v ...interface{}) {
@@ -449,8 +628,14 @@ func (l *logger) Tracef(format string, v ...interface{}) {
 // Output method is similar to a Logger.Output() method, however the multiLogger will
 // range through all of its configured loggers and execute the same Output() method call
 // on each of them
+//
+// A Logger failing to write the event does not prevent the remaining ones from writing it,
+// and calls its own error handler (if set). The errors are returned wrapped as one
 func (l *multiLogger) Output(m *event.Event) (n int, err error) {
-	var firstn int
+	var (
+		firstn int
+		errs   []error
+	)
 
 	for _, logger := range l.loggers {
 		n, err := logger.Output(m)
@@ -460,11 +645,11 @@ func (l *multiLogger) Output(m *event.Event) (n int, err error) {
 		}
 
 		if err != nil {
-			return firstn, err
+			errs = append(errs, err)
 		}
 	}
 
-	return firstn, err
+	return firstn, wrapErrors("writing", errs)
 }
 
 // Print method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
@@ -502,9 +687,27 @@ func (l *multiLogger) Printf(format string, v ...interface{}) {
 //
 // While the resulting error message of running `Logger.Output()` is simply ignored, this is done
 // as a blind-write for this Logger.
+//
+// Fatal and panic events are written to all Loggers, which are then flushed and closed, before
+// exiting or panicking once (if the multiLogger is not set to skip exit calls)
 func (l *multiLogger) Log(m ...*event.Event) {
-	for _, logger := range l.loggers {
-		logger.Log(m...)
+	for _, msg := range m {
+		if msg == nil {
+			continue
+		}
+
+		s := msg.GetMsg()
+
+		for _, logger := range l.loggers {
+			_, _ = logger.Output(msg) // deliberately ignore error in this method call
+		}
+
+		// all Loggers are flushed and closed before exiting once
+		if !l.IsSkipExit() && msg.GetLevel() == event.Level_panic {
+			l.panicWith(s)
+		} else if !l.IsSkipExit() && msg.GetLevel() == event.Level_fatal {
+			l.exitWith(1)
+		}
 	}
 }
 
@@ -526,7 +729,7 @@ func (l *multiLogger) Panic(v ...interface{}) {
 	}
 
 	if !l.IsSkipExit() {
-		panic(s)
+		l.panicWith(s)
 	}
 }
 
@@ -543,7 +746,7 @@ func (l *multiLogger) Panicln(v ...interface{}) {
 	}
 
 	if !l.IsSkipExit() {
-		panic(s)
+		l.panicWith(s)
 	}
 }
 
@@ -560,7 +763,7 @@ func (l *multiLogger) Panicf(format string, v ...interface{}) {
 	}
 
 	if !l.IsSkipExit() {
-		panic(s)
+		l.panicWith(s)
 	}
 }
 
@@ -574,7 +777,7 @@ func (l *multiLogger) Fatal(v ...interface{}) {
 	}
 
 	if !l.IsSkipExit() {
-		os.Exit(1)
+		l.exitWith(1)
 	}
 }
 
@@ -588,7 +791,7 @@ func (l *multiLogger) Fatalln(v ...interface{}) {
 	}
 
 	if !l.IsSkipExit() {
-		os.Exit(1)
+		l.exitWith(1)
 	}
 }
 
@@ -602,7 +805,7 @@ func (l *multiLogger) Fatalf(format string, v ...interface{}) {
 	}
 
 	if !l.IsSkipExit() {
-		os.Exit(1)
+		l.exitWith(1)
 	}
 }
 
@@ -630,6 +833,24 @@ func (l *multiLogger) Errorf(format string, v ...interface{}) {
 	}
 }
 
+// Err method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Error and recording the
+// input error in the event.
+func (l *multiLogger) Err(err error, v ...interface{}) {
+	for _, logger := range l.loggers {
+		logger.Err(err, v...)
+	}
+}
+
+// Errf method (similar to fmt.Printf) will print a message using an fmt.Sprintf(format, v...) pattern
+// across all configured Loggers, while automatically applying LogLevel Error and recording the
+// input error in the event.
+func (l *multiLogger) Errf(err error, format string, v ...interface{}) {
+	for _, logger := range l.loggers {
+		logger.Errf(err, format, v...)
+	}
+}
+
 // Warn method (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern
 // across all configured Loggers, while automatically applying LogLevel Warn.
 func (l *multiLogger) Warn(v ...interface{}) {
@@ -826,6 +1047,18 @@ func Errorf(format string, v ...interface{}) {
 	std.Errorf(format, v...)
 }
 
+// Err function (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern, while
+// automatically applying LogLevel Error and recording the input error in the event.
+func Err(err error, v ...interface{}) {
+	std.Err(err, v...)
+}
+
+// Errf function (similar to fmt.Printf) will print a message using an fmt.Sprintf(format, v...) pattern,
+// while automatically applying LogLevel Error and recording the input error in the event.
+func Errf(err error, format string, v ...interface{}) {
+	std.Errf(err, format, v...)
+}
+
 // Warn function (similar to fmt.Print) will print a message using an fmt.Sprint(v...) pattern, while
 // automatically applying LogLevel Warn.
 func Warn(v ...interface{}) {
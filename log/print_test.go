@@ -3,6 +3,7 @@ package log
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"reflect"
 	"strings"
@@ -52,33 +53,44 @@ func (l *testLogger) AddOuts(outs ...io.Writer) Logger {
 func (l *testLogger) Prefix(prefix string) Logger                 { return l }
 func (l *testLogger) Sub(sub string) Logger                       { return l }
 func (l *testLogger) Fields(fields map[string]interface{}) Logger { return l }
-func (l *testLogger) IsSkipExit() bool                            { return true }
-func (l *testLogger) Output(m *event.Event) (n int, err error)    { return l.Write(m.Encode()) }
-func (l *testLogger) Log(m ...*event.Event)                       {}
-func (l *testLogger) Print(v ...interface{})                      {}
-func (l *testLogger) Println(v ...interface{})                    {}
-func (l *testLogger) Printf(format string, v ...interface{})      {}
-func (l *testLogger) Panic(v ...interface{})                      {}
-func (l *testLogger) Panicln(v ...interface{})                    {}
-func (l *testLogger) Panicf(format string, v ...interface{})      {}
-func (l *testLogger) Fatal(v ...interface{})                      {}
-func (l *testLogger) Fatalln(v ...interface{})                    {}
-func (l *testLogger) Fatalf(format string, v ...interface{})      {}
-func (l *testLogger) Error(v ...interface{})                      {}
-func (l *testLogger) Errorln(v ...interface{})                    {}
-func (l *testLogger) Errorf(format string, v ...interface{})      {}
-func (l *testLogger) Warn(v ...interface{})                       {}
-func (l *testLogger) Warnln(v ...interface{})                     {}
-func (l *testLogger) Warnf(format string, v ...interface{})       {}
-func (l *testLogger) Info(v ...interface{})                       {}
-func (l *testLogger) Infoln(v ...interface{})                     {}
-func (l *testLogger) Infof(format string, v ...interface{})       {}
-func (l *testLogger) Debug(v ...interface{})                      {}
-func (l *testLogger) Debugln(v ...interface{})                    {}
-func (l *testLogger) Debugf(format string, v ...interface{})      {}
-func (l *testLogger) Trace(v ...interface{})                      {}
-func (l *testLogger) Traceln(v ...interface{})                    {}
-func (l *testLogger) Tracef(format string, v ...interface{})      {}
+func (l *testLogger) With(fields map[string]interface{}) Logger   { return l }
+func (l *testLogger) Child(prefix, sub string, fields map[string]interface{}) Logger {
+	return l
+}
+func (l *testLogger) Start(name string, fields map[string]interface{}) *Scope {
+	return StartScope(l, name, fields)
+}
+func (l *testLogger) IsSkipExit() bool                                { return true }
+func (l *testLogger) Sync() error                                     { return nil }
+func (l *testLogger) Close() error                                    { return nil }
+func (l *testLogger) Output(m *event.Event) (n int, err error)        { return l.Write(m.Encode()) }
+func (l *testLogger) Log(m ...*event.Event)                           {}
+func (l *testLogger) Print(v ...interface{})                          {}
+func (l *testLogger) Println(v ...interface{})                        {}
+func (l *testLogger) Printf(format string, v ...interface{})          {}
+func (l *testLogger) Panic(v ...interface{})                          {}
+func (l *testLogger) Panicln(v ...interface{})                        {}
+func (l *testLogger) Panicf(format string, v ...interface{})          {}
+func (l *testLogger) Fatal(v ...interface{})                          {}
+func (l *testLogger) Fatalln(v ...interface{})                        {}
+func (l *testLogger) Fatalf(format string, v ...interface{})          {}
+func (l *testLogger) Error(v ...interface{})                          {}
+func (l *testLogger) Errorln(v ...interface{})                        {}
+func (l *testLogger) Errorf(format string, v ...interface{})          {}
+func (l *testLogger) Err(err error, v ...interface{})                 {}
+func (l *testLogger) Errf(err error, format string, v ...interface{}) {}
+func (l *testLogger) Warn(v ...interface{})                           {}
+func (l *testLogger) Warnln(v ...interface{})                         {}
+func (l *testLogger) Warnf(format string, v ...interface{})           {}
+func (l *testLogger) Info(v ...interface{})                           {}
+func (l *testLogger) Infoln(v ...interface{})                         {}
+func (l *testLogger) Infof(format string, v ...interface{})           {}
+func (l *testLogger) Debug(v ...interface{})                          {}
+func (l *testLogger) Debugln(v ...interface{})                        {}
+func (l *testLogger) Debugf(format string, v ...interface{})          {}
+func (l *testLogger) Trace(v ...interface{})                          {}
+func (l *testLogger) Traceln(v ...interface{})                        {}
+func (l *testLogger) Tracef(format string, v ...interface{})          {}
 
 func recUnwrap(err error, errs *[]error) {
 	if err == nil {
@@ -3707,3 +3719,103 @@ func TestNilLoggerPrint(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestLoggerErr(t *testing.T) {
+	module := "Logger"
+	funcname := "Err()"
+
+	type test struct {
+		name string
+		call func(l Logger)
+		msg  string
+	}
+
+	var inner = errors.New("inner")
+	var err = fmt.Errorf("wrap: %w", inner)
+
+	var tests = []test{
+		{
+			name: "Err() without a message",
+			call: func(l Logger) { l.Err(err) },
+			msg:  "wrap: inner",
+		},
+		{
+			name: "Err() with a message",
+			call: func(l Logger) { l.Err(err, "null") },
+			msg:  "null",
+		},
+		{
+			name: "Errf() with a message",
+			call: func(l Logger) { l.Errf(err, "%s", "null") },
+			msg:  "null",
+		},
+		{
+			name: "MultiLogger Err()",
+			call: func(l Logger) { MultiLogger(l, New(NilConfig)).Err(err) },
+			msg:  "wrap: inner",
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		var e *event.Event
+
+		logger := New(WithOut(new(bytes.Buffer)), WithHook(func(m *event.Event) bool {
+			e = m
+			return true
+		}))
+
+		test.call(logger)
+
+		if e == nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected an event to be written -- action: %s", idx, module, funcname, test.name)
+			return
+		}
+
+		if e.GetLevel() != event.Level_error || e.GetMsg() != test.msg {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %q ; got [%s] %q -- action: %s", idx, module, funcname, test.msg, e.GetLevel().String(), e.GetMsg(), test.name)
+			return
+		}
+
+		if e.GetError().GetMessage() != err.Error() || len(e.GetError().GetChain()) != 1 || e.GetError().GetChain()[0].GetMessage() != inner.Error() {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error value: %v -- action: %s", idx, module, funcname, e.GetError(), test.name)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestLoggerMetadataData(t *testing.T) {
+	module := "Logger"
+	funcname := "checkDefaults()"
+
+	var e *event.Event
+
+	logger := New(WithOut(new(bytes.Buffer)), WithHook(func(m *event.Event) bool {
+		e = m
+		return true
+	}))
+
+	logger.Fields(map[string]interface{}{"id": int64(1<<62 + 1), "typed": "no"})
+
+	logger.Log(event.New().Message("null").Metadata(map[string]interface{}{"typed": 1, "a": true}).Build())
+
+	if e.Metadata()["id"] != int64(1<<62+1) {
+		t.Errorf("[%s] [%s] expected the Logger's typed value; got %v", module, funcname, e.Metadata())
+	}
+
+	// the Logger's untyped value replaces the event's typed one
+	if _, ok := e.GetData()["typed"]; ok || e.Metadata()["typed"] != "no" {
+		t.Errorf("[%s] [%s] expected the typed value to be replaced; got %v", module, funcname, e.Metadata())
+	}
+}
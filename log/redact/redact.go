@@ -0,0 +1,328 @@
+// Package redact provides a Redactor, which scrubs sensitive data (such as passwords, tokens,
+// emails or card numbers) from event.Events before they are formatted and written.
+//
+// A Redactor is built from a set of Rules, which either match metadata keys by their path
+// (e.g. `user.password`) or string values by a regular expression (e.g. JWTs). Each Rule
+// applies an Action to its matches:
+//   - Replace, replacing the value (or match) with a placeholder string
+//   - Drop, removing the metadata field (or the match) altogether
+//   - Hash(key), replacing the value (or match) with its (keyed) SHA-256 digest
+//   - Mask(n), masking the value (or match) except for its last n characters
+//
+// Rules are applied to nested maps and lists within the event.Event's metadata, and optionally
+// to its message:
+//
+//	r := redact.New(
+//	    redact.Key("user.password", redact.Drop),
+//	    redact.Key("*.token", redact.Hash(nil)),
+//	    redact.Value(redact.PatternCard, redact.Mask(4)),
+//	    redact.Value(redact.PatternEmail, redact.Replace),
+//	).Msg()
+//
+//	logger := log.New(log.WithRedactor(r))
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+const (
+	// Placeholder is the string that replaces values redacted with the Replace Action
+	Placeholder string = "[REDACTED]"
+
+	hashPrefix string = "sha256:"
+	hashLen    int    = 16
+	maskRune   rune   = '*'
+)
+
+var (
+	// PatternJWT matches JSON Web Tokens
+	PatternJWT = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	// PatternCard matches (13 to 19 digit) card numbers, optionally separated by spaces or dashes
+	PatternCard = regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)
+	// PatternEmail matches email addresses
+	PatternEmail = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+)
+
+type actionMode int
+
+const (
+	modeReplace actionMode = iota
+	modeDrop
+	modeHash
+	modeMask
+)
+
+// Action struct defines what is done to the data matched by a Rule
+type Action struct {
+	mode actionMode
+	key  []byte
+	keep int
+}
+
+var (
+	// Replace will replace the matched data with the Placeholder string
+	Replace = Action{mode: modeReplace}
+	// Drop will remove the matched metadata field, or the matched substring from a value
+	Drop = Action{mode: modeDrop}
+)
+
+// Hash function will create an Action that replaces the matched data with its SHA-256 digest
+// (an HMAC-SHA256 one if a key is provided), so that the same values can still be correlated
+// across events without being exposed
+func Hash(key []byte) Action {
+	return Action{mode: modeHash, key: key}
+}
+
+// Mask function will create an Action that masks the matched data with asterisks, except for
+// its last `keep` characters
+func Mask(keep int) Action {
+	if keep < 0 {
+		keep = 0
+	}
+
+	return Action{mode: modeMask, keep: keep}
+}
+
+func (a Action) apply(s string) string {
+	switch a.mode {
+	case modeDrop:
+		return ""
+	case modeHash:
+		var sum []byte
+
+		if len(a.key) > 0 {
+			h := hmac.New(sha256.New, a.key)
+			h.Write([]byte(s))
+			sum = h.Sum(nil)
+		} else {
+			s := sha256.Sum256([]byte(s))
+			sum = s[:]
+		}
+
+		return hashPrefix + hex.EncodeToString(sum)[:hashLen]
+	case modeMask:
+		r := []rune(s)
+
+		for i := 0; i < len(r)-a.keep; i++ {
+			r[i] = maskRune
+		}
+
+		return string(r)
+	default:
+		return Placeholder
+	}
+}
+
+// Rule struct describes a match (either of a metadata key path or of a value pattern) and the
+// Action to apply to it
+type Rule struct {
+	path   []string
+	re     *regexp.Regexp
+	action Action
+}
+
+// Key function will create a Rule which applies the input Action to the metadata field in
+// `path`. The path is a dot-separated list of (case-insensitive) keys into nested maps, where
+// a `*` matches any key; lists are traversed transparently.
+//
+// Matched values which are not strings (such as nested maps) are redacted as a whole
+func Key(path string, action Action) Rule {
+	return Rule{
+		path:   strings.Split(strings.ToLower(path), "."),
+		action: action,
+	}
+}
+
+// Value function will create a Rule which applies the input Action to all substrings of string
+// values (in any metadata field) that match the input regular expression
+func Value(re *regexp.Regexp, action Action) Rule {
+	return Rule{
+		re:     re,
+		action: action,
+	}
+}
+
+func (r Rule) matchPath(path []string) bool {
+	if len(r.path) != len(path) {
+		return false
+	}
+
+	for idx, seg := range r.path {
+		if seg != "*" && seg != strings.ToLower(path[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Redactor struct applies a set of Rules to event.Events
+type Redactor struct {
+	mu     sync.RWMutex
+	keys   []Rule
+	values []Rule
+	msg    bool
+}
+
+// New function will create a Redactor from the input Rules
+func New(rules ...Rule) *Redactor {
+	r := &Redactor{}
+
+	for _, rule := range rules {
+		r.add(rule)
+	}
+
+	return r
+}
+
+func (r *Redactor) add(rule Rule) {
+	switch {
+	case rule.re != nil:
+		r.values = append(r.values, rule)
+	case len(rule.path) > 0:
+		r.keys = append(r.keys, rule)
+	}
+}
+
+// Add method will add the input Rules to the Redactor, and return it
+func (r *Redactor) Add(rules ...Rule) *Redactor {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rule := range rules {
+		r.add(rule)
+	}
+
+	return r
+}
+
+// Msg method will set the Redactor to also apply its value Rules to the event.Events' messages,
+// and return it
+func (r *Redactor) Msg() *Redactor {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.msg = true
+	return r
+}
+
+// Redact method will scrub the input event.Event's metadata (and message, if configured) in place
+func (r *Redactor) Redact(m *event.Event) {
+	if m == nil {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.msg && m.GetMsg() != "" {
+		msg := r.value(m.GetMsg())
+		m.Msg = &msg
+	}
+
+	if m.Meta == nil || len(m.Meta.GetFields()) == 0 {
+		return
+	}
+
+	meta := m.Metadata()
+
+	if r.walkMap(nil, meta) {
+		m.SetMetadata(meta)
+	}
+}
+
+// Map method will scrub the input map in place, returning it
+func (r *Redactor) Map(meta map[string]interface{}) map[string]interface{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	r.walkMap(nil, meta)
+	return meta
+}
+
+// walkMap method redacts the input map in place, returning true if it was changed
+func (r *Redactor) walkMap(path []string, meta map[string]interface{}) (changed bool) {
+	for k, v := range meta {
+		p := append(path[:len(path):len(path)], k)
+
+		if rule, ok := r.keyRule(p); ok {
+			changed = true
+
+			if rule.action.mode == modeDrop {
+				delete(meta, k)
+				continue
+			}
+
+			s, isString := v.(string)
+
+			if !isString {
+				s = fmt.Sprint(v)
+			}
+
+			meta[k] = rule.action.apply(s)
+			continue
+		}
+
+		nv, ok := r.walk(p, v)
+
+		if ok {
+			meta[k] = nv
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// walk method redacts the input value, returning the new value and true if it was changed
+func (r *Redactor) walk(path []string, v interface{}) (interface{}, bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return t, r.walkMap(path, t)
+	case event.Field:
+		return t, r.walkMap(path, t)
+	case []interface{}:
+		var changed bool
+
+		for idx := range t {
+			if nv, ok := r.walk(path, t[idx]); ok {
+				t[idx] = nv
+				changed = true
+			}
+		}
+
+		return t, changed
+	case string:
+		s := r.value(t)
+		return s, s != t
+	default:
+		return v, false
+	}
+}
+
+func (r *Redactor) keyRule(path []string) (Rule, bool) {
+	for _, rule := range r.keys {
+		if rule.matchPath(path) {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+func (r *Redactor) value(s string) string {
+	for _, rule := range r.values {
+		s = rule.re.ReplaceAllStringFunc(s, rule.action.apply)
+	}
+
+	return s
+}
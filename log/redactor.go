@@ -0,0 +1,43 @@
+package log
+
+import (
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// Redactor interface describes a module that scrubs sensitive data from an event.Event in place,
+// such as the one in the log/redact package
+type Redactor interface {
+	Redact(m *event.Event)
+}
+
+// LCRedactor struct is a custom LoggerConfig to define a Redactor for new Loggers
+type LCRedactor struct {
+	r Redactor
+}
+
+// Apply method will set the configured Redactor to the input pointer to a LoggerBuilder
+func (c *LCRedactor) Apply(lb *LoggerBuilder) {
+	lb.Redactor = c.r
+}
+
+// WithRedactor function will allow creating a LoggerConfig that scrubs the events written by a
+// Logger with the input Redactor. It returns nil if the Redactor is nil.
+//
+// Events are redacted after the Logger applies its defaults (so its own metadata is also covered),
+// and before they are passed to any Hooks:
+//
+//	logger := log.New(
+//	    log.WithRedactor(redact.New(
+//	        redact.Key("user.password", redact.Drop),
+//	        redact.Value(redact.PatternEmail, redact.Hash(nil)),
+//	    )),
+//	)
+func WithRedactor(r Redactor) LoggerConfig {
+	if r == nil {
+		return nil
+	}
+
+	return &LCRedactor{
+		r: r,
+	}
+}
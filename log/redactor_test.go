@@ -0,0 +1,55 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+	"github.com/zalgonoise/zlog/log/redact"
+)
+
+func TestWithRedactor(t *testing.T) {
+	module := "Logger"
+	funcname := "WithRedactor()"
+
+	buf := new(bytes.Buffer)
+	var seen string
+
+	logger := New(
+		WithOut(buf),
+		SkipExit,
+		WithFormat(FormatJSON),
+		WithRedactor(redact.New(
+			redact.Key("password", redact.Drop),
+			redact.Value(redact.PatternEmail, redact.Replace),
+		).Msg()),
+		WithHook(func(m *event.Event) bool {
+			seen = m.GetMsg()
+			return true
+		}),
+	)
+
+	logger.Fields(map[string]interface{}{"password": "hunter2"})
+	logger.Log(event.New().Message("user joe@example.com logged in").Metadata(map[string]interface{}{
+		"email": "joe@example.com",
+	}).Build())
+
+	out := buf.String()
+
+	if strings.Contains(out, "hunter2") || strings.Contains(out, "joe@example.com") {
+		t.Errorf("[%s] [%s] expected sensitive data to be redacted; got %q", module, funcname, out)
+	}
+
+	if !strings.Contains(out, redact.Placeholder) {
+		t.Errorf("[%s] [%s] expected the redaction placeholder in the output; got %q", module, funcname, out)
+	}
+
+	if seen != "user "+redact.Placeholder+" logged in" {
+		t.Errorf("[%s] [%s] expected hooks to receive the redacted event; got %q", module, funcname, seen)
+	}
+
+	if WithRedactor(nil) != nil {
+		t.Errorf("[%s] [%s] expected a nil config for a nil Redactor", module, funcname)
+	}
+}
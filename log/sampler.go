@@ -0,0 +1,288 @@
+package log
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+const (
+	samplerPrefix string = "log"
+	samplerSub    string = "sampler"
+)
+
+// Sampler struct describes a rate-limiting module for a Logger, which will let through the first
+// N events of a kind, within an interval, and then 1-in-M of the remaining ones.
+//
+// Events are grouped (and counted) by their level, prefix and sub-prefix. The limits applied to
+// each group are resolved in the following order:
+//   - a rule set for the event's prefix and sub-prefix, with Sampler.Prefix(prefix, sub, ...)
+//   - a rule set for the event's prefix (any sub-prefix), with Sampler.Prefix(prefix, "", ...)
+//   - a rule set for the event's level, with Sampler.Level(...)
+//   - the default rule, as set in NewSampler()
+//
+// Fatal and panic events are never sampled. Dropped events are counted, and once an interval in which
+// events were dropped elapses, a summary event is written to the Logger with the totals (this summary
+// event skips sampling). The counters of the groups whose interval elapsed are cleared on each tick,
+// so that they do not pile up with the number of distinct groups. A Sampler should be used by a
+// single Logger.
+type Sampler struct {
+	mu       sync.Mutex
+	interval time.Duration
+	def      sampleRule
+	levels   map[event.Level]sampleRule
+	prefixes map[string]sampleRule
+	counters map[sampleKey]*sampleCounter
+	dropped  map[sampleKey]uint64
+	total    uint64
+	timer    *time.Timer
+	report   func(m *event.Event)
+}
+
+type sampleRule struct {
+	first      int
+	thereafter int
+}
+
+type sampleKey struct {
+	level  event.Level
+	prefix string
+	sub    string
+}
+
+type sampleCounter struct {
+	start time.Time
+	count int
+}
+
+// LCSampler struct is a custom LoggerConfig to define a Sampler for new Loggers
+type LCSampler struct {
+	s *Sampler
+}
+
+// Apply method will set the configured Sampler to the input pointer to a LoggerBuilder
+func (c *LCSampler) Apply(lb *LoggerBuilder) {
+	lb.Sampler = c.s
+}
+
+// WithSampler function will allow creating a LoggerConfig that samples the events written by a Logger,
+// as per the input Sampler's configuration
+//
+//	logger := log.New(
+//	    log.WithSampler(
+//	        log.NewSampler(time.Second, 100, 10).    // first 100 events per second, then 1-in-10
+//	            Level(event.Level_debug, 10, 100).   // first 10 debug events per second, then 1-in-100
+//	            Prefix("http", "access", 5, 50),     // first 5 [http] [access] events per second, then 1-in-50
+//	    ),
+//	)
+func WithSampler(s *Sampler) LoggerConfig {
+	if s == nil {
+		return nil
+	}
+
+	return &LCSampler{
+		s: s,
+	}
+}
+
+// NewSampler function will create a Sampler that lets through the `first` events within an `interval`,
+// and then one in each `thereafter` events, for each kind of event (level, prefix and sub-prefix).
+//
+// A zero or negative `thereafter` value will drop all events above the `first` ones within the interval.
+// A zero or negative interval defaults to one second.
+func NewSampler(interval time.Duration, first, thereafter int) *Sampler {
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	return &Sampler{
+		interval: interval,
+		def:      sampleRule{first: first, thereafter: thereafter},
+		levels:   map[event.Level]sampleRule{},
+		prefixes: map[string]sampleRule{},
+		counters: map[sampleKey]*sampleCounter{},
+		dropped:  map[sampleKey]uint64{},
+	}
+}
+
+// Level method will set specific sampling limits for events of level `level`, and return the Sampler
+func (s *Sampler) Level(level event.Level, first, thereafter int) *Sampler {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.levels[level] = sampleRule{first: first, thereafter: thereafter}
+	return s
+}
+
+// Prefix method will set specific sampling limits for events with prefix `prefix` and sub-prefix `sub`,
+// and return the Sampler. An empty `sub` value will match any sub-prefix within `prefix`.
+func (s *Sampler) Prefix(prefix, sub string, first, thereafter int) *Sampler {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prefixes[prefixKey(prefix, sub)] = sampleRule{first: first, thereafter: thereafter}
+	return s
+}
+
+// Dropped method returns the total number of events dropped by this Sampler
+func (s *Sampler) Dropped() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.total
+}
+
+func prefixKey(prefix, sub string) string {
+	return prefix + "\x00" + sub
+}
+
+func (s *Sampler) rule(k sampleKey) sampleRule {
+	if r, ok := s.prefixes[prefixKey(k.prefix, k.sub)]; ok {
+		return r
+	}
+
+	if r, ok := s.prefixes[prefixKey(k.prefix, "")]; ok {
+		return r
+	}
+
+	if r, ok := s.levels[k.level]; ok {
+		return r
+	}
+
+	return s.def
+}
+
+// bind method sets the function used to write summary events
+func (s *Sampler) bind(fn func(m *event.Event)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.report = fn
+}
+
+// Sample method will return a boolean on whether the input event.Event should be written, or
+// if it should be dropped. Fatal and panic events are always written
+func (s *Sampler) Sample(m *event.Event) bool {
+	if level := m.GetLevel(); level == event.Level_fatal || level == event.Level_panic {
+		return true
+	}
+
+	k := sampleKey{
+		level:  m.GetLevel(),
+		prefix: m.GetPrefix(),
+		sub:    m.GetSub(),
+	}
+
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.counters[k]
+
+	if !ok || now.Sub(c.start) >= s.interval {
+		c = &sampleCounter{start: now}
+		s.counters[k] = c
+		s.arm()
+	}
+
+	c.count++
+
+	r := s.rule(k)
+
+	if c.count <= r.first {
+		return true
+	}
+
+	if r.thereafter > 0 && (c.count-r.first)%r.thereafter == 0 {
+		return true
+	}
+
+	s.dropped[k]++
+	s.total++
+
+	return false
+}
+
+// arm method starts the timer that clears the elapsed counters and writes the summary events, if
+// not running. It must be called while holding the lock
+func (s *Sampler) arm() {
+	if s.timer == nil {
+		s.timer = time.AfterFunc(s.interval, s.flush)
+	}
+}
+
+// Flush method will write a summary event with the events dropped so far immediately, regardless
+// of the interval having elapsed, and stop the Sampler's timer; clearing all of its counters
+func (s *Sampler) Flush() {
+	s.mu.Lock()
+
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	s.counters = map[sampleKey]*sampleCounter{}
+
+	s.mu.Unlock()
+
+	s.flush()
+}
+
+// flush method will clear the counters whose interval elapsed, and write a summary event with the
+// events dropped since the last summary, resetting these counters
+func (s *Sampler) flush() {
+	s.mu.Lock()
+
+	s.timer = nil
+	now := time.Now()
+
+	for k, c := range s.counters {
+		if now.Sub(c.start) >= s.interval {
+			delete(s.counters, k)
+		}
+	}
+
+	// keep ticking while there are counters to clear
+	if len(s.counters) > 0 {
+		s.arm()
+	}
+
+	if len(s.dropped) == 0 || s.report == nil {
+		s.mu.Unlock()
+		return
+	}
+
+	var total uint64
+	var groups = make([]map[string]interface{}, 0, len(s.dropped))
+
+	for k, n := range s.dropped {
+		total += n
+		groups = append(groups, map[string]interface{}{
+			"level":   k.level.String(),
+			"prefix":  k.prefix,
+			"sub":     k.sub,
+			"dropped": n,
+		})
+	}
+
+	s.dropped = map[sampleKey]uint64{}
+	report := s.report
+	interval := s.interval
+
+	s.mu.Unlock()
+
+	report(event.New().
+		Level(event.Level_warn).
+		Prefix(samplerPrefix).
+		Sub(samplerSub).
+		Message(fmt.Sprintf("sampled out %d events in %s", total, interval)).
+		Metadata(map[string]interface{}{
+			"dropped":  total,
+			"interval": interval.String(),
+			"events":   groups,
+		}).
+		Build())
+}
@@ -0,0 +1,196 @@
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+func TestSampler(t *testing.T) {
+	module := "Sampler"
+	funcname := "Sample()"
+
+	type test struct {
+		name    string
+		sampler *Sampler
+		events  []*event.Event
+		wants   int
+	}
+
+	var newEvents = func(n int, level event.Level, prefix, sub string) []*event.Event {
+		var e []*event.Event
+		for i := 0; i < n; i++ {
+			e = append(e, event.New().Level(level).Prefix(prefix).Sub(sub).Message("sample").Build())
+		}
+		return e
+	}
+
+	var tests = []test{
+		{
+			name:    "first 5, then drop all",
+			sampler: NewSampler(time.Minute, 5, 0),
+			events:  newEvents(20, event.Level_info, "test", ""),
+			wants:   5,
+		},
+		{
+			name:    "first 5, then 1-in-5",
+			sampler: NewSampler(time.Minute, 5, 5),
+			events:  newEvents(20, event.Level_info, "test", ""),
+			wants:   8,
+		},
+		{
+			name:    "level-specific limits",
+			sampler: NewSampler(time.Minute, 5, 0).Level(event.Level_debug, 1, 0),
+			events: append(
+				newEvents(10, event.Level_debug, "test", ""),
+				newEvents(10, event.Level_info, "test", "")...,
+			),
+			wants: 6,
+		},
+		{
+			name:    "prefix-specific limits take precedence",
+			sampler: NewSampler(time.Minute, 5, 0).Level(event.Level_debug, 1, 0).Prefix("http", "", 2, 0).Prefix("http", "access", 3, 0),
+			events: append(
+				append(
+					newEvents(10, event.Level_debug, "http", ""),
+					newEvents(10, event.Level_debug, "http", "access")...,
+				),
+				newEvents(10, event.Level_debug, "db", "")...,
+			),
+			wants: 6,
+		},
+		{
+			name:    "fatal and panic events are never sampled",
+			sampler: NewSampler(time.Minute, 0, 0),
+			events: append(
+				newEvents(5, event.Level_fatal, "test", ""),
+				newEvents(5, event.Level_panic, "test", "")...,
+			),
+			wants: 10,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		buf := new(bytes.Buffer)
+		logger := New(WithOut(buf), SkipExit, CfgTextOnly, WithSampler(test.sampler))
+
+		logger.Log(test.events...)
+
+		lines := strings.Count(buf.String(), "\n")
+
+		if lines != test.wants {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v events ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				lines,
+				test.name,
+			)
+			return
+		}
+
+		if dropped := test.sampler.Dropped(); dropped != uint64(len(test.events)-test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] dropped counter mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				len(test.events)-test.wants,
+				dropped,
+				test.name,
+			)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestSamplerSummary(t *testing.T) {
+	module := "Sampler"
+	funcname := "flush()"
+
+	buf := new(bytes.Buffer)
+	sampler := NewSampler(50*time.Millisecond, 1, 0)
+	logger := New(WithOut(buf), SkipExit, CfgFormatJSON, WithSampler(sampler))
+
+	for i := 0; i < 10; i++ {
+		logger.Info("sampled")
+	}
+
+	// interval resets the counters
+	time.Sleep(100 * time.Millisecond)
+	logger.Info("sampled")
+
+	out := buf.String()
+
+	if n := strings.Count(out, `"message":"sampled"`); n != 2 {
+		t.Errorf("[%s] [%s] expected 2 sampled events, got %v: %s", module, funcname, n, out)
+	}
+
+	if !strings.Contains(out, "sampled out 9 events") {
+		t.Errorf("[%s] [%s] summary event not found in output: %s", module, funcname, out)
+	}
+}
+
+func TestWithSampler(t *testing.T) {
+	if WithSampler(nil) != nil {
+		t.Errorf("[LoggerConfig] [WithSampler()] expected a nil config for a nil Sampler")
+	}
+
+	s := NewSampler(0, 1, 1)
+	if s.interval != time.Second {
+		t.Errorf("[LoggerConfig] [NewSampler()] expected default interval; got %v", s.interval)
+	}
+
+	builder := &LoggerBuilder{}
+	WithSampler(s).Apply(builder)
+
+	if builder.Sampler != s {
+		t.Errorf("[LoggerConfig] [WithSampler()] sampler was not applied to the builder")
+	}
+}
+
+func TestSamplerCounters(t *testing.T) {
+	module := "Sampler"
+	funcname := "Sample()"
+
+	s := NewSampler(20*time.Millisecond, 1, 0)
+
+	for i := 0; i < 100; i++ {
+		s.Sample(event.New().Prefix(fmt.Sprintf("prefix-%v", i)).Message("null").Build())
+	}
+
+	deadline := time.Now().Add(time.Second)
+
+	for time.Now().Before(deadline) {
+		s.mu.Lock()
+		counters, timer := len(s.counters), s.timer
+		s.mu.Unlock()
+
+		if counters == 0 && timer == nil {
+			t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "clear elapsed counters")
+			return
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	t.Errorf("#%v -- FAILED -- [%s] [%s] expected the elapsed counters to be cleared and the timer stopped -- action: %s", 0, module, funcname, "clear elapsed counters")
+}
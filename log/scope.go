@@ -0,0 +1,312 @@
+package log
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+const (
+	// ScopeKey is the metadata key holding a Scope's start and end event details
+	ScopeKey string = "scope"
+	// ScopeIDKey is the metadata key holding the ID of the Scope an event was written in, for
+	// the events written by a Scope's Logger
+	ScopeIDKey string = "scope_id"
+)
+
+// Scope outcomes, as set in the end event's metadata
+const (
+	ScopeOK    string = "ok"
+	ScopeSlow  string = "slow"
+	ScopeError string = "error"
+)
+
+// ScopeConfig struct describes the levels of the events written by Scopes, and the duration
+// after which a Scope is considered slow (and its end event escalated). A zero Slow duration
+// disables the threshold
+type ScopeConfig struct {
+	StartLevel event.Level
+	EndLevel   event.Level
+	Slow       time.Duration
+}
+
+// defaultScopeConfig is used by Loggers without a ScopeConfig, writing start events as trace
+// and end events as debug
+var defaultScopeConfig = ScopeConfig{
+	StartLevel: event.Level_trace,
+	EndLevel:   event.Level_debug,
+}
+
+// LCScope struct is a custom LoggerConfig to define the levels and slow threshold of the
+// events written by the Scopes of new Loggers
+type LCScope struct {
+	conf ScopeConfig
+}
+
+// Apply method will set the configured ScopeConfig to the input pointer to a LoggerBuilder
+func (c *LCScope) Apply(lb *LoggerBuilder) {
+	conf := c.conf
+	lb.Scopes = &conf
+}
+
+// WithScopes function will allow creating a LoggerConfig that sets the levels of the start and
+// end events written by a Logger's Scopes (see Logger.Start()), and the duration after which a
+// Scope's end event is escalated to warn. It returns nil if either level is invalid, or if the
+// duration is negative.
+//
+// By default, start events are written as trace and end events as debug, with no slow threshold:
+//
+//	logger := log.New(log.WithScopes(event.Level_debug, event.Level_info, 200*time.Millisecond))
+func WithScopes(start, end event.Level, slow time.Duration) LoggerConfig {
+	if _, ok := event.Level_name[int32(start)]; !ok {
+		return nil
+	}
+
+	if _, ok := event.Level_name[int32(end)]; !ok {
+		return nil
+	}
+
+	if slow < 0 {
+		return nil
+	}
+
+	return &LCScope{
+		conf: ScopeConfig{
+			StartLevel: start,
+			EndLevel:   end,
+			Slow:       slow,
+		},
+	}
+}
+
+// Scope struct describes a timed operation, started with Logger.Start() and finished with its
+// End() method; which write a start and an end event to the Logger.
+//
+// Each Scope has a unique ID, and nested Scopes (started with Scope.Start(), or from the Logger
+// returned by Scope.Logger()) record the ID of their parent; so that the events of a request can
+// be reconstructed as a tree
+type Scope struct {
+	logger Logger
+	conf   ScopeConfig
+	name   string
+	id     string
+	parent string
+	fields map[string]interface{}
+	start  time.Time
+
+	once    sync.Once
+	elapsed time.Duration
+}
+
+// StartScope function starts a Scope named `name` in the input Logger, writing its start event
+// with the input metadata fields, with the default ScopeConfig. It allows implementations of the
+// Logger interface to provide their Start() method:
+//
+//	func (l *myLogger) Start(name string, fields map[string]interface{}) *log.Scope {
+//	    return log.StartScope(l, name, fields)
+//	}
+func StartScope(l Logger, name string, fields map[string]interface{}) *Scope {
+	return newScope(l, defaultScopeConfig, "", name, fields)
+}
+
+// newScope function creates a Scope with the input parent ID (if any), and writes its start event
+func newScope(l Logger, conf ScopeConfig, parent, name string, fields map[string]interface{}) *Scope {
+	s := &Scope{
+		logger: l,
+		conf:   conf,
+		name:   name,
+		id:     uuid.New().String(),
+		parent: parent,
+		fields: fields,
+	}
+
+	l.Log(event.New().
+		Level(conf.StartLevel).
+		Message(fmt.Sprintf("%s: started", name)).
+		Metadata(s.metadata(map[string]interface{}{"stage": "start"})).
+		Build())
+
+	s.start = time.Now()
+
+	return s
+}
+
+// metadata method returns the Scope's fields, with the Scope's details (merged with the input ones)
+// under the ScopeKey
+func (s *Scope) metadata(details map[string]interface{}) map[string]interface{} {
+	scope := map[string]interface{}{
+		"name": s.name,
+		"id":   s.id,
+	}
+
+	if s.parent != "" {
+		scope["parent_id"] = s.parent
+	}
+
+	return merge(s.fields, map[string]interface{}{ScopeKey: merge(scope, details)})
+}
+
+// Name method returns the Scope's name
+func (s *Scope) Name() string {
+	return s.name
+}
+
+// ID method returns the Scope's unique ID
+func (s *Scope) ID() string {
+	return s.id
+}
+
+// ParentID method returns the ID of the Scope's parent, or an empty string if it has none
+func (s *Scope) ParentID() string {
+	return s.parent
+}
+
+// Start method starts a nested Scope, which records this Scope's ID as its parent
+func (s *Scope) Start(name string, fields map[string]interface{}) *Scope {
+	return newScope(s.logger, s.conf, s.id, name, fields)
+}
+
+// Logger method returns a child Logger whose events carry this Scope's ID (under the ScopeIDKey),
+// so that events written during the operation can be placed in the tree. Scopes started from the
+// returned Logger (or from its children) are nested in this one, including when the Scope was
+// started from a MultiLogger or a FanOutLogger
+func (s *Scope) Logger() Logger {
+	child := s.logger.With(map[string]interface{}{ScopeIDKey: s.id})
+
+	setScope(child, s)
+
+	return child
+}
+
+// setScope function records the input Scope as the parent of the Scopes started from the input
+// Logger, ranging through the Loggers of a multiLogger or fanOutLogger
+func setScope(l Logger, s *Scope) {
+	switch logger := l.(type) {
+	case *logger:
+		logger.scope = s
+	case *multiLogger:
+		for _, inner := range logger.loggers {
+			setScope(inner, s)
+		}
+	case *fanOutLogger:
+		setScope(logger.multiLogger, s)
+	}
+}
+
+// scopeSettings function returns the ScopeConfig and the parent Scope ID for the Scopes started
+// from the input Logger. A multiLogger or fanOutLogger writes a single Scope to all of its
+// Loggers, so it uses the settings of its first Logger that has any (in order)
+func scopeSettings(l Logger) (conf ScopeConfig, parent string, ok bool) {
+	switch logger := l.(type) {
+	case *logger:
+		conf = defaultScopeConfig
+
+		if logger.scopes != nil {
+			conf = *logger.scopes
+		}
+
+		if logger.scope != nil {
+			parent = logger.scope.id
+		}
+
+		return conf, parent, true
+	case *multiLogger:
+		for _, inner := range logger.loggers {
+			if conf, parent, ok = scopeSettings(inner); ok {
+				return conf, parent, true
+			}
+		}
+	case *fanOutLogger:
+		return scopeSettings(logger.multiLogger)
+	}
+
+	return defaultScopeConfig, "", false
+}
+
+// End method finishes the Scope, writing its end event with its duration and outcome, and the
+// input error (if not nil). It returns the Scope's duration.
+//
+// The end event is written with the configured end level, escalated to error if the input error
+// is not nil, or to warn if the Scope took longer than the slow threshold. Calling End() more than
+// once only returns the duration, without writing any events
+func (s *Scope) End(err error) time.Duration {
+	s.once.Do(func() {
+		s.elapsed = time.Since(s.start)
+
+		var (
+			level   = s.conf.EndLevel
+			outcome = ScopeOK
+			msg     = fmt.Sprintf("%s: done in %v", s.name, s.elapsed)
+		)
+
+		switch {
+		case err != nil:
+			level, outcome = escalate(level, event.Level_error), ScopeError
+			msg = fmt.Sprintf("%s: failed after %v", s.name, s.elapsed)
+		case s.conf.Slow > 0 && s.elapsed >= s.conf.Slow:
+			level, outcome = escalate(level, event.Level_warn), ScopeSlow
+		}
+
+		s.logger.Log(event.New().
+			Level(level).
+			Message(msg).
+			Metadata(s.metadata(map[string]interface{}{
+				"stage":       "end",
+				"outcome":     outcome,
+				"duration_ms": float64(s.elapsed) / float64(time.Millisecond),
+			})).
+			Err(err).
+			Build())
+	})
+
+	return s.elapsed
+}
+
+// escalate function returns the highest of the input levels
+func escalate(level, min event.Level) event.Level {
+	if level.Int() < min.Int() {
+		return min
+	}
+
+	return level
+}
+
+// Start method starts a Scope named `name`, writing its start event with the input metadata fields;
+// and returns it so that the operation is finished with Scope.End():
+//
+//	op := logger.Start("db.query", map[string]interface{}{"table": "users"})
+//	rows, err := db.Query(query)
+//	op.End(err)
+//
+// If the Logger was returned by a Scope's Logger() method, the new Scope is nested in that Scope
+func (l *logger) Start(name string, fields map[string]interface{}) *Scope {
+	conf, parent, _ := scopeSettings(l)
+
+	return newScope(l, conf, parent, name, fields)
+}
+
+// Start method is similar to a Logger.Start() method, however the multiLogger writes the Scope's
+// events to all of its Loggers. The Scope takes its levels and slow threshold (see WithScopes())
+// from the first of its Loggers
+func (l *multiLogger) Start(name string, fields map[string]interface{}) *Scope {
+	conf, parent, _ := scopeSettings(l)
+
+	return newScope(l, conf, parent, name, fields)
+}
+
+// Start method is similar to a Logger.Start() method, however the fanOutLogger writes the Scope's
+// events to its Loggers in parallel. The Scope takes its levels and slow threshold (see
+// WithScopes()) from the first of its Loggers
+func (l *fanOutLogger) Start(name string, fields map[string]interface{}) *Scope {
+	conf, parent, _ := scopeSettings(l)
+
+	return newScope(l, conf, parent, name, fields)
+}
+
+// Start method returns a Scope which writes no events
+func (l *nilLogger) Start(name string, fields map[string]interface{}) *Scope {
+	return StartScope(l, name, fields)
+}
@@ -0,0 +1,329 @@
+package log
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// newScopeLogger returns a Logger which collects its events in the returned slice
+func newScopeLogger(confs ...LoggerConfig) (Logger, *[]*event.Event) {
+	var events = new([]*event.Event)
+
+	confs = append([]LoggerConfig{
+		WithOut(io.Discard),
+		SkipExit,
+		CfgTextOnly,
+		WithHook(func(m *event.Event) bool {
+			*events = append(*events, m)
+			return true
+		}),
+	}, confs...)
+
+	return New(confs...), events
+}
+
+// scopeOf function returns the scope details in the input event's metadata
+func scopeOf(m *event.Event) map[string]interface{} {
+	scope, _ := m.Metadata()[ScopeKey].(map[string]interface{})
+	return scope
+}
+
+func TestScopeEnd(t *testing.T) {
+	module := "Scope"
+	funcname := "End()"
+
+	var errQuery = errors.New("connection reset")
+
+	type test struct {
+		name       string
+		confs      []LoggerConfig
+		delay      time.Duration
+		err        error
+		startLevel event.Level
+		endLevel   event.Level
+		outcome    string
+	}
+
+	var tests = []test{
+		{
+			name:       "default levels",
+			startLevel: event.Level_trace,
+			endLevel:   event.Level_debug,
+			outcome:    ScopeOK,
+		},
+		{
+			name:       "failed operation",
+			err:        errQuery,
+			startLevel: event.Level_trace,
+			endLevel:   event.Level_error,
+			outcome:    ScopeError,
+		},
+		{
+			name:       "custom levels",
+			confs:      []LoggerConfig{WithScopes(event.Level_debug, event.Level_info, time.Second)},
+			startLevel: event.Level_debug,
+			endLevel:   event.Level_info,
+			outcome:    ScopeOK,
+		},
+		{
+			name:       "slow operation",
+			confs:      []LoggerConfig{WithScopes(event.Level_debug, event.Level_info, time.Millisecond)},
+			delay:      5 * time.Millisecond,
+			startLevel: event.Level_debug,
+			endLevel:   event.Level_warn,
+			outcome:    ScopeSlow,
+		},
+		{
+			name:       "slow and failed operation",
+			confs:      []LoggerConfig{WithScopes(event.Level_debug, event.Level_info, time.Millisecond)},
+			delay:      5 * time.Millisecond,
+			err:        errQuery,
+			startLevel: event.Level_debug,
+			endLevel:   event.Level_error,
+			outcome:    ScopeError,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		logger, events := newScopeLogger(test.confs...)
+
+		op := logger.Start("db.query", map[string]interface{}{"table": "users"})
+		time.Sleep(test.delay)
+		elapsed := op.End(test.err)
+
+		if len(*events) != 2 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected 2 events; got %v -- action: %s", idx, module, funcname, len(*events), test.name)
+			return
+		}
+
+		start, end := (*events)[0], (*events)[1]
+
+		if start.GetLevel() != test.startLevel || end.GetLevel() != test.endLevel {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] level mismatch: wanted %s and %s ; got %s and %s -- action: %s", idx, module, funcname, test.startLevel, test.endLevel, start.GetLevel(), end.GetLevel(), test.name)
+			return
+		}
+
+		if elapsed < test.delay {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected a duration of at least %v; got %v -- action: %s", idx, module, funcname, test.delay, elapsed, test.name)
+			return
+		}
+
+		scope := scopeOf(end)
+
+		if scope["outcome"] != test.outcome || scope["stage"] != "end" || scope["id"] != op.ID() || scope["name"] != "db.query" {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected scope details: %v -- action: %s", idx, module, funcname, scope, test.name)
+			return
+		}
+
+		if ms, ok := scope["duration_ms"].(float64); !ok || ms != float64(elapsed)/float64(time.Millisecond) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] duration mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, elapsed, scope["duration_ms"], test.name)
+			return
+		}
+
+		if end.Metadata()["table"] != "users" || scopeOf(start)["stage"] != "start" {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected metadata: %v ; %v -- action: %s", idx, module, funcname, start.Metadata(), end.Metadata(), test.name)
+			return
+		}
+
+		if test.err != nil && end.GetError().GetMessage() != test.err.Error() {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.err, end.GetError(), test.name)
+			return
+		}
+
+		// ending a Scope again does not write any events
+		if again := op.End(nil); again != elapsed || len(*events) != 2 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected no events when ending twice; got %v -- action: %s", idx, module, funcname, len(*events), test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestScopeNested(t *testing.T) {
+	module := "Scope"
+	funcname := "Start()"
+
+	logger, events := newScopeLogger()
+
+	req := logger.Start("request", nil)
+	query := req.Start("db.query", nil)
+	query.End(nil)
+
+	scoped := req.Logger()
+	scoped.Info("cache miss")
+	cache := scoped.Child("", "cache", nil).Start("cache.set", nil)
+	cache.End(nil)
+	req.End(nil)
+
+	if req.ParentID() != "" || query.ParentID() != req.ID() || cache.ParentID() != req.ID() || query.ID() == req.ID() {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected scope IDs: %s ; %s -> %s ; %s -> %s -- action: %s", 0, module, funcname, req.ID(), query.ParentID(), query.ID(), cache.ParentID(), cache.ID(), "nested scopes")
+		return
+	}
+
+	var parents = map[string]string{}
+
+	for _, m := range *events {
+		if scope := scopeOf(m); scope != nil {
+			parent, _ := scope["parent_id"].(string)
+			parents[scope["id"].(string)] = parent
+		}
+	}
+
+	if len(parents) != 3 || parents[query.ID()] != req.ID() || parents[cache.ID()] != req.ID() || parents[req.ID()] != "" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected scope tree: %v -- action: %s", 1, module, funcname, parents, "rebuild the tree from the events")
+		return
+	}
+
+	if m := (*events)[3]; m.GetMsg() != "cache miss" || m.Metadata()[ScopeIDKey] != req.ID() {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the scope ID in the event; got %v -- action: %s", 2, module, funcname, m.Metadata(), "events within a scope")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "nested scopes")
+}
+
+func TestWithScopes(t *testing.T) {
+	module := "LoggerConfig"
+	funcname := "WithScopes()"
+
+	type test struct {
+		name  string
+		start event.Level
+		end   event.Level
+		slow  time.Duration
+		ok    bool
+	}
+
+	var tests = []test{
+		{
+			name:  "valid config",
+			start: event.Level_debug,
+			end:   event.Level_info,
+			slow:  time.Second,
+			ok:    true,
+		},
+		{
+			name:  "invalid start level",
+			start: event.Level(7),
+			end:   event.Level_info,
+		},
+		{
+			name:  "invalid end level",
+			start: event.Level_debug,
+			end:   event.Level(-1),
+		},
+		{
+			name:  "negative threshold",
+			start: event.Level_debug,
+			end:   event.Level_info,
+			slow:  -time.Second,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		conf := WithScopes(test.start, test.end, test.slow)
+
+		if (conf != nil) != test.ok {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected a valid config: %v ; got %v -- action: %s", idx, module, funcname, test.ok, conf, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestMultiLoggerStart(t *testing.T) {
+	module := "MultiLogger"
+	funcname := "Start()"
+
+	l1, events1 := newScopeLogger()
+	l2, events2 := newScopeLogger()
+
+	MultiLogger(l1, l2).Start("job", nil).End(nil)
+
+	for idx, events := range []*[]*event.Event{events1, events2} {
+		if len(*events) != 2 || scopeOf((*events)[1])["outcome"] != ScopeOK {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected start and end events; got %v -- action: %s", idx, module, funcname, len(*events), "write scope to all loggers")
+			return
+		}
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "write scope to all loggers")
+}
+
+func TestMultiLoggerScopeNested(t *testing.T) {
+	module := "MultiLogger"
+	funcname := "Scope.Logger()"
+
+	type test struct {
+		name string
+		wrap func(loggers ...Logger) Logger
+	}
+
+	var tests = []test{
+		{
+			name: "nested scopes in a MultiLogger",
+			wrap: MultiLogger,
+		},
+		{
+			name: "nested scopes in a FanOutLogger",
+			wrap: func(loggers ...Logger) Logger {
+				return FanOutLogger(0, loggers...)
+			},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		l1, events1 := newScopeLogger(WithScopes(event.Level_debug, event.Level_info, 0))
+		l2, events2 := newScopeLogger()
+
+		logger := test.wrap(l1, l2)
+		defer logger.Close()
+
+		req := logger.Start("request", nil)
+		query := req.Logger().Child("", "db", nil).Start("db.query", nil)
+		query.End(nil)
+		req.End(nil)
+
+		if query.ParentID() != req.ID() {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected parent ID %s ; got %s -- action: %s", idx, module, funcname, req.ID(), query.ParentID(), test.name)
+			return
+		}
+
+		for _, events := range []*[]*event.Event{events1, events2} {
+			if len(*events) != 4 {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] expected 4 events; got %v -- action: %s", idx, module, funcname, len(*events), test.name)
+				return
+			}
+
+			if (*events)[0].GetLevel() != event.Level_debug || (*events)[3].GetLevel() != event.Level_info {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] expected the first Logger's scope levels; got %v and %v -- action: %s", idx, module, funcname, (*events)[0].GetLevel(), (*events)[3].GetLevel(), test.name)
+				return
+			}
+
+			if parent := scopeOf((*events)[1])["parent_id"]; parent != req.ID() {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] expected parent ID %s in the event; got %v -- action: %s", idx, module, funcname, req.ID(), parent, test.name)
+				return
+			}
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
@@ -0,0 +1,144 @@
+package log
+
+import (
+	"io"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// Sink struct describes an additional output for a Logger, with its own io.Writer, LogFormatter
+// and level filter
+type Sink struct {
+	out   io.Writer
+	fmt   LogFormatter
+	level event.Level
+}
+
+// NewSink function will create a Sink that writes events of level `level` and above to the input
+// io.Writer, formatted with the input LogFormatter. A nil LogFormatter will use the Logger's own
+func NewSink(out io.Writer, fmt LogFormatter, level event.Level) Sink {
+	return Sink{
+		out:   out,
+		fmt:   fmt,
+		level: level,
+	}
+}
+
+// LCSink struct is a custom LoggerConfig to add Sinks to new Loggers
+type LCSink struct {
+	s Sink
+}
+
+// Apply method will add the configured Sink to the input pointer to a LoggerBuilder's Sinks
+func (c *LCSink) Apply(lb *LoggerBuilder) {
+	lb.Sinks = append(lb.Sinks, c.s)
+}
+
+// WithSink function will allow creating a LoggerConfig that adds an output to a Logger, with its own
+// LogFormatter and level filter. It returns nil if the input io.Writer is nil.
+//
+// Sinks are written to alongside the Logger's own io.Writer, so a single Logger can write colored
+// text to stderr, JSON to a logfile and only warnings and above to a database:
+//
+//	logger := log.New(
+//	    log.WithOut(os.Stderr),
+//	    log.WithFormat(text.New().Color().Build()),
+//	    log.WithSink(logfile, log.FormatJSON, event.Level_trace),
+//	    log.WithSink(db, log.FormatProtobuf, event.Level_warn),
+//	)
+//
+// Each event is formatted once per distinct LogFormatter. The Logger's own level filter (set with
+// WithFilter()) is applied before any Sink's.
+func WithSink(out io.Writer, fmt LogFormatter, level event.Level) LoggerConfig {
+	if out == nil {
+		return nil
+	}
+
+	return &LCSink{
+		s: NewSink(out, fmt, level),
+	}
+}
+
+// formatCache struct keeps the output of each LogFormatter for a single event.Event, so that it
+// is only formatted once by each of them
+type formatCache struct {
+	fmts []LogFormatter
+	bufs [][]byte
+	errs []error
+}
+
+func (c *formatCache) format(f LogFormatter, m *event.Event) ([]byte, error) {
+	if reflect.TypeOf(f).Comparable() {
+		for idx, cached := range c.fmts {
+			if cached == f {
+				return c.bufs[idx], c.errs[idx]
+			}
+		}
+	}
+
+	buf, err := f.Format(m)
+
+	c.fmts = append(c.fmts, f)
+	c.bufs = append(c.bufs, buf)
+	c.errs = append(c.errs, err)
+
+	return buf, err
+}
+
+// writeSinks method will write the input event.Event to all Sinks that accept its level, reusing
+// the Logger's already-formatted buffer (or format error) when the formatters match. Sinks without
+// their own LogFormatter are skipped if the Logger's failed, as its error is already registered. It
+// returns the first error raised by a Sink.
+func (l *logger) writeSinks(m *event.Event, sinks []Sink, f LogFormatter, buf []byte, fmtErr error) (err error) {
+	c := &l.root().counters
+
+	cache := &formatCache{
+		fmts: []LogFormatter{f},
+		bufs: [][]byte{buf},
+		errs: []error{fmtErr},
+	}
+
+	for _, s := range sinks {
+		if m.GetLevel().Int() < s.level.Int() {
+			continue
+		}
+
+		sf := s.fmt
+
+		if sf == nil {
+			if fmtErr != nil {
+				continue
+			}
+
+			sf = f
+		}
+
+		sbuf, ferr := cache.format(sf, m)
+
+		if ferr != nil {
+			atomic.AddUint64(&c.formatErrors, 1)
+
+			if err == nil {
+				err = ferr
+			}
+			continue
+		}
+
+		n, werr := s.out.Write(sbuf)
+
+		if werr != nil {
+			atomic.AddUint64(&c.writeErrors, 1)
+
+			if err == nil {
+				err = werr
+			}
+			continue
+		}
+
+		c.written(n)
+	}
+
+	return err
+}
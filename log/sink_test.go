@@ -0,0 +1,170 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// countingFormatter wraps a LogFormatter, counting the number of Format() calls
+type countingFormatter struct {
+	f     LogFormatter
+	calls int
+}
+
+func (f *countingFormatter) Format(m *event.Event) ([]byte, error) {
+	f.calls++
+	return f.f.Format(m)
+}
+
+func TestWithSink(t *testing.T) {
+	module := "Logger"
+	funcname := "WithSink()"
+
+	type test struct {
+		name   string
+		event  *event.Event
+		main   string
+		text   string
+		json   bool
+		warn   bool
+		tCalls int
+		jCalls int
+	}
+
+	var tests = []test{
+		{
+			name:   "info event skips the warn sink",
+			event:  event.New().Level(event.Level_info).Message("info").Build(),
+			main:   "info\n",
+			text:   "info\n",
+			json:   true,
+			tCalls: 1,
+			jCalls: 1,
+		},
+		{
+			name:   "error event is written to all sinks",
+			event:  event.New().Level(event.Level_error).Message("error").Build(),
+			main:   "error\n",
+			text:   "error\n",
+			json:   true,
+			warn:   true,
+			tCalls: 1,
+			jCalls: 1,
+		},
+		{
+			name:   "debug event is only written to the trace sinks",
+			event:  event.New().Level(event.Level_debug).Message("debug").Build(),
+			main:   "debug\n",
+			json:   true,
+			tCalls: 1,
+			jCalls: 1,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		textFmt := &countingFormatter{f: FormatText}
+		jsonFmt := &countingFormatter{f: FormatJSON}
+
+		var (
+			main     = new(bytes.Buffer)
+			textSink = new(bytes.Buffer)
+			jsonSink = new(bytes.Buffer)
+			warnSink = new(bytes.Buffer)
+		)
+
+		logger := New(
+			WithOut(main),
+			WithFormat(textFmt),
+			SkipExit,
+			WithSink(textSink, nil, event.Level_info),
+			WithSink(jsonSink, jsonFmt, event.Level_trace),
+			WithSink(warnSink, jsonFmt, event.Level_warn),
+		)
+
+		if _, err := logger.Output(test.event); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+			return
+		}
+
+		if !bytes.HasSuffix(main.Bytes(), []byte(test.main)) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected main output: %q -- action: %s", idx, module, funcname, main.String(), test.name)
+			return
+		}
+
+		if test.text != "" && textSink.String() != main.String() || test.text == "" && textSink.Len() > 0 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected text sink output: %q -- action: %s", idx, module, funcname, textSink.String(), test.name)
+			return
+		}
+
+		if (jsonSink.Len() > 0) != test.json || (warnSink.Len() > 0) != test.warn {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected JSON sinks output: %q ; %q -- action: %s", idx, module, funcname, jsonSink.String(), warnSink.String(), test.name)
+			return
+		}
+
+		if test.warn && jsonSink.String() != warnSink.String() {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected matching JSON sinks output: %q ; %q -- action: %s", idx, module, funcname, jsonSink.String(), warnSink.String(), test.name)
+			return
+		}
+
+		if textFmt.calls != test.tCalls || jsonFmt.calls != test.jCalls {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected Format() calls: text %v, json %v -- action: %s", idx, module, funcname, textFmt.calls, jsonFmt.calls, test.name)
+			return
+		}
+
+		t.Logf(
+			"#%v -- PASSED -- [%s] [%s] -- action: %s",
+			idx,
+			module,
+			funcname,
+			test.name,
+		)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	if WithSink(nil, FormatJSON, event.Level_info) != nil {
+		t.Errorf("[%s] [%s] expected a nil config for a nil io.Writer", module, funcname)
+	}
+}
+
+func TestSinkFormatError(t *testing.T) {
+	module := "Logger"
+	funcname := "WithSink()"
+
+	var (
+		main     = new(bytes.Buffer)
+		textSink = new(bytes.Buffer)
+		jsonSink = new(bytes.Buffer)
+		errs     int
+	)
+
+	logger := New(
+		WithOut(main),
+		WithFormat(testFailingFormatter{}),
+		SkipExit,
+		WithSink(textSink, nil, event.Level_trace),
+		WithSink(jsonSink, FormatJSON, event.Level_trace),
+		WithErrorHandler(func(err error, ev *event.Event) { errs++ }),
+	)
+
+	if _, err := logger.Output(event.New().Level(event.Level_info).Message("null").Build()); err == nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the format error -- action: %s", 0, module, funcname, "write to the sinks when the logger's formatter fails")
+		return
+	}
+
+	if main.Len() > 0 || textSink.Len() > 0 || !bytes.Contains(jsonSink.Bytes(), []byte(`"message":"null"`)) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected output: %q ; %q ; %q -- action: %s", 0, module, funcname, main.String(), textSink.String(), jsonSink.String(), "write to the sinks when the logger's formatter fails")
+		return
+	}
+
+	if m := logger.(MetricsLogger).Metrics(); errs != 1 || m.FormatErrors != 1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a single format error; got %v handled and %v counted -- action: %s", 0, module, funcname, errs, m.FormatErrors, "write to the sinks when the logger's formatter fails")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "write to the sinks when the logger's formatter fails")
+}
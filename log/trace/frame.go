@@ -0,0 +1,196 @@
+package trace
+
+import (
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Frame struct describes a symbolized call stack frame: its function (with its package path),
+// source file and line
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// Package method returns the import path of the Frame's function package, such as
+// `github.com/zalgonoise/zlog/log` for `github.com/zalgonoise/zlog/log.(*logger).Info`
+func (f Frame) Package() string {
+	slash := strings.LastIndex(f.Function, "/")
+
+	if dot := strings.Index(f.Function[slash+1:], "."); dot >= 0 {
+		return f.Function[:slash+1+dot]
+	}
+
+	return f.Function
+}
+
+// Short method returns the Frame's source file (with its parent directory only) and line,
+// such as `log/logger.go:42`
+func (f Frame) Short() string {
+	dir, base := path.Split(f.File)
+
+	if dir != "" {
+		base = path.Join(path.Base(dir), base)
+	}
+
+	return base + ":" + strconv.Itoa(f.Line)
+}
+
+// String method implements fmt.Stringer, returning the Frame in a compact format, such as
+// `main.main (app/main.go:12)`
+func (f Frame) String() string {
+	return f.Function + " (" + f.Short() + ")"
+}
+
+// Goroutine struct describes the call stack of a goroutine, with its ID and status (such as
+// `running` or `chan receive`)
+type Goroutine struct {
+	ID     int
+	Status string
+	Frames []Frame
+}
+
+// Stack type is a list of goroutines' call stacks, as captured by a Tracer
+type Stack []Goroutine
+
+// String method implements fmt.Stringer, returning the Stack in a compact format with one line
+// per goroutine header and frame:
+//
+//	goroutine 1 [running]:
+//		main.operation (app/main.go:15)
+//		main.main (app/main.go:42)
+func (s Stack) String() string {
+	var sb strings.Builder
+
+	for _, g := range s {
+		sb.WriteString(goroutinePrefix)
+		sb.WriteString(strconv.Itoa(g.ID))
+		sb.WriteString(" [")
+		sb.WriteString(g.Status)
+		sb.WriteString("]:\n")
+
+		for _, f := range g.Frames {
+			sb.WriteString("\t")
+			sb.WriteString(f.String())
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// Map method returns the Stack as a map[string]interface{}, to be added to an event's metadata.
+// Each goroutine is keyed as `goroutine-<id>`, holding its `id`, `status` and `stack`; where the
+// stack is a list of frames with their `method` (function) and `reference` (file and line)
+func (s Stack) Map() map[string]interface{} {
+	out := make(map[string]interface{}, len(s))
+
+	for _, g := range s {
+		frames := make([]map[string]interface{}, 0, len(g.Frames))
+
+		for _, f := range g.Frames {
+			frames = append(frames, map[string]interface{}{
+				mKey: f.Function,
+				rKey: f.File + ":" + strconv.Itoa(f.Line),
+			})
+		}
+
+		id := strconv.Itoa(g.ID)
+
+		out[goRKey+id] = map[string]interface{}{
+			idKey:     id,
+			statusKey: g.Status,
+			stackKey:  frames,
+		}
+	}
+
+	return out
+}
+
+// FromMap function converts the map view of a Stack (as returned by its Map() method, or decoded
+// from an event's metadata) back into a Stack, sorted by goroutine ID. It returns false if the
+// input map is empty or not a call stack
+func FromMap(m map[string]interface{}) (Stack, bool) {
+	if len(m) == 0 {
+		return nil, false
+	}
+
+	var s = make(Stack, 0, len(m))
+
+	for k, v := range m {
+		routine, ok := v.(map[string]interface{})
+
+		if !ok || !strings.HasPrefix(k, goRKey) {
+			return nil, false
+		}
+
+		idStr, _ := routine[idKey].(string)
+		id, err := strconv.Atoi(idStr)
+
+		if err != nil {
+			return nil, false
+		}
+
+		status, _ := routine[statusKey].(string)
+		frames, ok := framesFromMap(routine[stackKey])
+
+		if !ok {
+			return nil, false
+		}
+
+		s = append(s, Goroutine{
+			ID:     id,
+			Status: status,
+			Frames: frames,
+		})
+	}
+
+	sort.Slice(s, func(i, j int) bool { return s[i].ID < s[j].ID })
+
+	return s, true
+}
+
+// framesFromMap function converts a list of frames in their map view into Frames, accepting
+// both typed lists and the []interface{} lists of decoded metadata
+func framesFromMap(v interface{}) ([]Frame, bool) {
+	var list []map[string]interface{}
+
+	switch value := v.(type) {
+	case nil:
+	case []map[string]interface{}:
+		list = value
+	case []interface{}:
+		list = make([]map[string]interface{}, 0, len(value))
+
+		for _, item := range value {
+			m, ok := item.(map[string]interface{})
+
+			if !ok {
+				return nil, false
+			}
+
+			list = append(list, m)
+		}
+	default:
+		return nil, false
+	}
+
+	frames := make([]Frame, 0, len(list))
+
+	for _, m := range list {
+		function, _ := m[mKey].(string)
+		reference, _ := m[rKey].(string)
+		file, line := parseFileLine(reference)
+
+		frames = append(frames, Frame{
+			Function: function,
+			File:     file,
+			Line:     line,
+		})
+	}
+
+	return frames, true
+}
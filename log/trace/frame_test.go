@@ -0,0 +1,154 @@
+package trace
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testStack = Stack{
+	{
+		ID:     1,
+		Status: "running",
+		Frames: []Frame{
+			{Function: "github.com/org/app/db.(*Client).Query", File: "/go/app/db/client.go", Line: 88},
+			{Function: "main.main", File: "/go/app/main.go", Line: 12},
+		},
+	},
+	{
+		ID:     4,
+		Status: "select",
+		Frames: []Frame{
+			{Function: "main.worker", File: "/go/app/main.go", Line: 30},
+		},
+	},
+}
+
+func TestFramePackage(t *testing.T) {
+	module := "Frame"
+	funcname := "Package()"
+
+	var tests = map[string]string{
+		"main.main":                                    "main",
+		"net/http.(*conn).serve":                       "net/http",
+		"github.com/org/app/db.(*Client).Query":        "github.com/org/app/db",
+		"github.com/org/app/db.New.func1":              "github.com/org/app/db",
+		"github.com/zalgonoise/zlog/log.(*logger).Log": "github.com/zalgonoise/zlog/log",
+	}
+
+	var idx int
+
+	for function, wants := range tests {
+		if got := (Frame{Function: function}).Package(); got != wants {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %s ; got %s -- action: %s", idx, module, funcname, wants, got, function)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, function)
+		idx++
+	}
+}
+
+func TestStackString(t *testing.T) {
+	module := "Stack"
+	funcname := "String()"
+
+	wants := `goroutine 1 [running]:
+	github.com/org/app/db.(*Client).Query (db/client.go:88)
+	main.main (app/main.go:12)
+goroutine 4 [select]:
+	main.worker (app/main.go:30)
+`
+
+	if got := testStack.String(); got != wants {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %q ; got %q -- action: %s", 0, module, funcname, wants, got, "compact format")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "compact format")
+}
+
+func TestStackMap(t *testing.T) {
+	module := "Stack"
+	funcname := "Map()"
+
+	m := testStack.Map()
+
+	wants := map[string]interface{}{
+		"id":     "4",
+		"status": "select",
+		"stack": []map[string]interface{}{
+			{"method": "main.worker", "reference": "/go/app/main.go:30"},
+		},
+	}
+
+	if !reflect.DeepEqual(m["goroutine-4"], wants) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s", 0, module, funcname, wants, m["goroutine-4"], "map view")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "map view")
+}
+
+func TestFromMap(t *testing.T) {
+	module := "Trace"
+	funcname := "FromMap()"
+
+	type test struct {
+		name  string
+		input map[string]interface{}
+		wants Stack
+		ok    bool
+	}
+
+	var tests = []test{
+		{
+			name:  "map view",
+			input: testStack.Map(),
+			wants: testStack,
+			ok:    true,
+		},
+		{
+			name: "decoded metadata",
+			input: map[string]interface{}{
+				"goroutine-4": map[string]interface{}{
+					"id":     "4",
+					"status": "select",
+					"stack": []interface{}{
+						map[string]interface{}{"method": "main.worker", "reference": "/go/app/main.go:30"},
+					},
+				},
+			},
+			wants: testStack[1:],
+			ok:    true,
+		},
+		{
+			name:  "empty map",
+			input: map[string]interface{}{},
+		},
+		{
+			name:  "not a call stack",
+			input: map[string]interface{}{"goroutine-1": "running"},
+		},
+		{
+			name: "invalid ID",
+			input: map[string]interface{}{
+				"goroutine-x": map[string]interface{}{"id": "x"},
+			},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		s, ok := FromMap(test.input)
+
+		if ok != test.ok || (test.ok && !reflect.DeepEqual(s, test.wants)) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v (%v) ; got %v (%v) -- action: %s", idx, module, funcname, test.wants, test.ok, s, ok, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
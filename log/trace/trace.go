@@ -1,13 +1,46 @@
+// Package trace captures call stacks as typed Frames, to be added to log events.
+//
+// The current goroutine's call stack is captured with runtime.Callers() and symbolized with
+// runtime.CallersFrames(), caching the symbolized frames by program counter; so that capturing
+// the same call sites repeatedly is cheap. The call stacks of all goroutines are parsed from a
+// runtime.Stack() dump, sized to fit all of them.
+//
+// A Tracer limits the depth of the captured call stacks, and drops the frames matching its
+// Filters -- by default, the frames of the Go runtime, standard library and of this library:
+//
+//	tracer := trace.NewTracer(8, trace.SkipRuntime, trace.SkipStdlib, trace.SkipZlog)
+//
+//	stack := tracer.Capture(0)
+//	fmt.Print(stack)         // compact, one line per frame
+//	meta := stack.Map()      // map view, for an event's metadata
 package trace
 
 import (
-	"regexp"
 	"runtime"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 const (
-	callStackMaxSize int    = 1024
-	goRoutineRegexp  string = `^goroutine (\d*) \[(.*)\]:$`
+	// DefaultDepth is the maximum number of frames per goroutine kept by the default Tracer
+	DefaultDepth int = 32
+
+	// Key is the metadata key holding the call stack added to an event
+	Key string = "callstack"
+
+	// callersHeadroom is the number of additional program counters captured, to make up for
+	// the frames dropped by the Tracer's Filters
+	callersHeadroom int = 32
+
+	// initial and maximum buffer sizes for a runtime.Stack() dump of all goroutines
+	stackDumpSize    int = 64 << 10
+	stackDumpMaxSize int = 64 << 20
+
+	goroutinePrefix string = "goroutine "
+	createdByPrefix string = "created by "
+	zlogPrefix      string = "github.com/zalgonoise/zlog/"
+	examplesPrefix  string = "github.com/zalgonoise/zlog/examples/"
 
 	mKey      string = "method"
 	rKey      string = "reference"
@@ -17,142 +50,336 @@ const (
 	goRKey    string = "goroutine-"
 )
 
-type stacktrace struct {
-	buf    []byte
-	split  [][]byte
-	stacks []goRoutine
-	out    map[string]interface{}
+// DefaultTracer is the Tracer used by New() and Capture(), which keeps up to DefaultDepth
+// frames, dropping the frames of the Go runtime, standard library and of this library
+var DefaultTracer = NewTracer(DefaultDepth, SkipRuntime, SkipStdlib, SkipZlog)
+
+// frameCache maps program counters to their symbolized Frames
+var frameCache sync.Map
+
+// Filter type is a function which returns true for the Frames to drop from a call stack
+type Filter func(f Frame) bool
+
+// SkipRuntime function is a Filter which drops the frames of the Go runtime
+func SkipRuntime(f Frame) bool {
+	return strings.HasPrefix(f.Function, "runtime.")
+}
+
+// SkipStdlib function is a Filter which drops the frames of the standard library (packages
+// whose import path has no dot in its first element), except for the main package
+func SkipStdlib(f Frame) bool {
+	pkg := f.Package()
+
+	if pkg == "" || pkg == "main" {
+		return false
+	}
+
+	if slash := strings.Index(pkg, "/"); slash >= 0 {
+		pkg = pkg[:slash]
+	}
+
+	return !strings.Contains(pkg, ".")
 }
 
-type callStack struct {
-	method    string
-	reference string
+// SkipZlog function is a Filter which drops the frames of this library, except for its tests
+// and examples
+func SkipZlog(f Frame) bool {
+	return strings.HasPrefix(f.Function, zlogPrefix) &&
+		!strings.HasPrefix(f.Function, examplesPrefix) &&
+		!strings.HasSuffix(f.File, "_test.go")
 }
-type goRoutine struct {
-	id     string
-	status string
-	stack  []callStack
+
+// Tracer struct captures call stacks, keeping up to a number of frames per goroutine and
+// dropping the frames matching any of its Filters
+type Tracer struct {
+	depth   int
+	filters []Filter
 }
 
-// New function will return the current callstack in a map[string]interface{} format,
-// to be added in an event's metadata
-func New(all bool) map[string]interface{} {
-	return newCallStack().
-		getCallStack(all).
-		splitCallStack().
-		parseCallStack().
-		mapCallStack().
-		asMap()
+// NewTracer function creates a Tracer which keeps up to `depth` frames per goroutine (or
+// DefaultDepth, if not positive), after dropping the frames matching any of the input Filters
+func NewTracer(depth int, filters ...Filter) *Tracer {
+	if depth <= 0 {
+		depth = DefaultDepth
+	}
+
+	f := make([]Filter, 0, len(filters))
+
+	for _, filter := range filters {
+		if filter != nil {
+			f = append(f, filter)
+		}
+	}
+
+	return &Tracer{
+		depth:   depth,
+		filters: f,
+	}
 }
 
-func newCallStack() *stacktrace {
-	return &stacktrace{}
+// Capture method returns the call stack of the current goroutine, starting at the function
+// calling Capture() -- or `skip` frames above it
+func (t *Tracer) Capture(skip int) Stack {
+	return t.capture(skip + 1)
 }
 
-func (s *stacktrace) getCallStack(all bool) *stacktrace {
-	buf := make([]byte, callStackMaxSize)
-	n := runtime.Stack(buf, all)
-	s.buf = buf[0:n]
+// capture method returns the call stack of the current goroutine, starting `skip` frames above
+// the function calling capture()
+func (t *Tracer) capture(skip int) Stack {
+	var frames []Frame
+
+	// capture more frames if too many of them were dropped
+	for size := t.depth + callersHeadroom; ; size *= 2 {
+		pcs := make([]uintptr, size)
+
+		// skip runtime.Callers and capture
+		n := runtime.Callers(skip+2, pcs)
+		frames = t.filter(Frames(pcs[:n]))
+
+		if n < size || len(frames) >= t.depth {
+			break
+		}
+	}
+
+	return Stack{{
+		ID:     goroutineID(),
+		Status: "running",
+		Frames: frames,
+	}}
+}
+
+// CaptureAll method returns the call stacks of all goroutines
+func (t *Tracer) CaptureAll() Stack {
+	s := parseStack(stackDump())
+
+	for idx := range s {
+		s[idx].Frames = t.filter(s[idx].Frames)
+	}
 
 	return s
 }
 
-func (s *stacktrace) splitCallStack() *stacktrace {
-	if s.buf == nil || len(s.buf) == 0 {
-		s.getCallStack(false)
+// filter method drops the input Frames matching any of the Tracer's Filters, keeping up to
+// the Tracer's depth
+func (t *Tracer) filter(frames []Frame) []Frame {
+	out := frames[:0]
+
+	for _, f := range frames {
+		if len(out) == t.depth {
+			break
+		}
+
+		if !t.drop(f) {
+			out = append(out, f)
+		}
 	}
 
-	var line []byte
+	return out
+}
 
-	for i := 0; i < len(s.buf); i++ {
-		if s.buf[i] != 10 {
-			line = append(line, s.buf[i])
-		} else {
-			s.split = append(s.split, line)
-			line = []byte{}
+func (t *Tracer) drop(f Frame) bool {
+	for _, filter := range t.filters {
+		if filter(f) {
+			return true
 		}
 	}
 
-	if len(line) != 0 {
-		s.split = append(s.split, line)
+	return false
+}
+
+// New function will return the current callstack in a map[string]interface{} format,
+// to be added in an event's metadata. If `all` is true, the call stacks of all goroutines
+// are returned.
+//
+// It uses the DefaultTracer; see Stack.Map() for the format of the returned map
+func New(all bool) map[string]interface{} {
+	if all {
+		return DefaultTracer.CaptureAll().Map()
 	}
 
-	return s
+	return DefaultTracer.capture(1).Map()
+}
+
+// Capture function returns the call stack of the current goroutine with the DefaultTracer,
+// starting at the function calling Capture() -- or `skip` frames above it
+func Capture(skip int) Stack {
+	return DefaultTracer.capture(skip + 1)
 }
 
-func (s *stacktrace) parseCallStack() *stacktrace {
-	if s.split == nil || len(s.split) == 0 {
-		s.splitCallStack()
+// Frames function symbolizes the input program counters (as returned by runtime.Callers()),
+// expanding inlined calls. Symbolized frames are cached by program counter
+func Frames(pcs []uintptr) []Frame {
+	frames := make([]Frame, 0, len(pcs))
+
+	for _, pc := range pcs {
+		frames = append(frames, symbolize(pc)...)
 	}
 
-	cs := goRoutine{}
-	regxGoRoutine := regexp.MustCompile(goRoutineRegexp)
+	return frames
+}
 
-	for i := 0; i < len(s.split); i++ {
-		if regxGoRoutine.Match(s.split[i]) {
-			if cs.id != "" || cs.status != "" {
-				s.stacks = append(s.stacks, cs)
-				cs = goRoutine{}
-			}
-			match := regxGoRoutine.FindStringSubmatch(string(s.split[i]))
-			cs.id = match[1]
-			cs.status = match[2]
-		} else {
-			if i+1 < len(s.split) && len(s.split[i+1]) > 0 && s.split[i+1][0] == 9 {
-
-				s := callStack{
-					method:    string(s.split[i]),
-					reference: string(s.split[i+1][1:]),
-				}
-				cs.stack = append(cs.stack, s)
-			}
+// symbolize function returns the Frames for the input program counter, from the cache if
+// it was symbolized before
+func symbolize(pc uintptr) []Frame {
+	if cached, ok := frameCache.Load(pc); ok {
+		return cached.([]Frame)
+	}
+
+	var out []Frame
+
+	frames := runtime.CallersFrames([]uintptr{pc})
+
+	for {
+		f, more := frames.Next()
 
+		if f.Function != "" || f.File != "" {
+			out = append(out, Frame{
+				Function: f.Function,
+				File:     f.File,
+				Line:     f.Line,
+			})
 		}
-	}
 
-	if cs.id != "" || cs.status != "" {
-		s.stacks = append(s.stacks, cs)
+		if !more {
+			break
+		}
 	}
 
-	return s
+	frameCache.Store(pc, out)
+
+	return out
 }
 
-func (s *stacktrace) mapCallStack() *stacktrace {
+// goroutineID function returns the ID of the current goroutine, from the header of its
+// runtime.Stack() dump
+func goroutineID() int {
+	var buf [128]byte
+
+	n := runtime.Stack(buf[:], false)
+	line := string(buf[:n])
 
-	if s.stacks == nil || len(s.stacks) == 0 {
-		s.parseCallStack()
+	if nl := strings.IndexByte(line, '\n'); nl >= 0 {
+		line = line[:nl]
 	}
 
-	field := map[string]interface{}{}
+	id, _, _ := parseHeader(line)
+
+	return id
+}
+
+// stackDump function returns a runtime.Stack() dump of all goroutines, growing its buffer until
+// they all fit (up to stackDumpMaxSize)
+func stackDump() []byte {
+	for size := stackDumpSize; ; size *= 2 {
+		buf := make([]byte, size)
 
-	for _, s := range s.stacks {
-		stackMap := []map[string]interface{}{}
-		for _, v := range s.stack {
+		if n := runtime.Stack(buf, true); n < size || size >= stackDumpMaxSize {
+			return buf[:n]
+		}
+	}
+}
 
-			f := map[string]interface{}{
-				mKey: v.method,
-				rKey: v.reference,
+// parseStack function parses a runtime.Stack() dump into a Stack, where each goroutine is
+// introduced by a `goroutine <id> [<status>]:` header, followed by pairs of lines with a
+// function call and its (tab-indented) file, line and offset
+func parseStack(buf []byte) Stack {
+	var (
+		s     Stack
+		lines = strings.Split(string(buf), "\n")
+	)
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if strings.HasPrefix(line, goroutinePrefix) {
+			if id, status, ok := parseHeader(line); ok {
+				s = append(s, Goroutine{ID: id, Status: status})
 			}
 
-			stackMap = append(stackMap, f)
+			continue
 		}
 
-		field[goRKey+s.id] = map[string]interface{}{
-			idKey:     s.id,
-			statusKey: s.status,
-			stackKey:  stackMap,
+		// skip blank lines and notes like `...additional frames elided...`
+		if len(s) == 0 || line == "" || i+1 >= len(lines) || !strings.HasPrefix(lines[i+1], "\t") {
+			continue
 		}
 
-	}
+		file, lineNo := parseFileLine(lines[i+1][1:])
+		i++
 
-	s.out = field
+		g := &s[len(s)-1]
+		g.Frames = append(g.Frames, Frame{
+			Function: parseFunction(line),
+			File:     file,
+			Line:     lineNo,
+		})
+	}
 
 	return s
 }
 
-func (s *stacktrace) asMap() map[string]interface{} {
-	if s.out == nil || len(s.out) <= 0 {
-		s.mapCallStack()
+// parseHeader function parses a goroutine header line, returning its ID and status
+func parseHeader(line string) (id int, status string, ok bool) {
+	line = strings.TrimPrefix(line, goroutinePrefix)
+
+	end := strings.IndexByte(line, ' ')
+
+	if end < 0 {
+		return 0, "", false
+	}
+
+	id, err := strconv.Atoi(line[:end])
+
+	if err != nil {
+		return 0, "", false
 	}
-	return s.out
+
+	if start, stop := strings.IndexByte(line, '['), strings.LastIndexByte(line, ']'); start >= 0 && stop > start {
+		status = line[start+1 : stop]
+	}
+
+	return id, status, true
+}
+
+// parseFunction function returns the function name in a runtime.Stack() call line, without
+// its arguments; or the creator's function name in a `created by` line
+func parseFunction(line string) string {
+	if strings.HasPrefix(line, createdByPrefix) {
+		line = strings.TrimPrefix(line, createdByPrefix)
+
+		if idx := strings.Index(line, " in goroutine "); idx >= 0 {
+			line = line[:idx]
+		}
+
+		return line
+	}
+
+	if strings.HasSuffix(line, ")") {
+		if idx := strings.LastIndexByte(line, '('); idx > 0 {
+			return line[:idx]
+		}
+	}
+
+	return line
+}
+
+// parseFileLine function splits a `<file>:<line> +0x<offset>` reference into its file and line
+func parseFileLine(ref string) (file string, line int) {
+	if idx := strings.Index(ref, " +0x"); idx >= 0 {
+		ref = ref[:idx]
+	}
+
+	idx := strings.LastIndexByte(ref, ':')
+
+	if idx < 0 {
+		return ref, 0
+	}
+
+	line, err := strconv.Atoi(ref[idx+1:])
+
+	if err != nil {
+		return ref, 0
+	}
+
+	return ref[:idx], line
 }
@@ -1,8 +1,8 @@
 package trace
 
 import (
-	"fmt"
-	"strconv"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -48,595 +48,264 @@ func TestNew(t *testing.T) {
 	}
 }
 
-func TestGetCallStack(t *testing.T) {
-	module := "Trace"
-	funcname := "getCallstack()"
+// captureFrom is a named caller for the Tracer, to find in its call stacks
+func captureFrom(t *Tracer, skip int) Stack {
+	return t.Capture(skip)
+}
+
+func TestTracerCapture(t *testing.T) {
+	module := "Tracer"
+	funcname := "Capture()"
 
 	type test struct {
-		name  string
-		stack *stacktrace
-		all   bool
-		want  []byte
+		name   string
+		tracer *Tracer
+		skip   int
+		first  string
+		depth  int
+		denied []string
 	}
 
 	var tests = []test{
 		{
-			name:  "getting callstack: all = true",
-			stack: newCallStack(),
-			all:   true,
-			want:  []byte("goroutine"),
+			name:   "default tracer",
+			tracer: DefaultTracer,
+			first:  "github.com/zalgonoise/zlog/log/trace.captureFrom",
+			denied: []string{"runtime.", "testing."},
+		},
+		{
+			name:   "skip a frame",
+			tracer: DefaultTracer,
+			skip:   1,
+			first:  "github.com/zalgonoise/zlog/log/trace.TestTracerCapture.func1",
+		},
+		{
+			name:   "limited depth",
+			tracer: NewTracer(1),
+			first:  "github.com/zalgonoise/zlog/log/trace.captureFrom",
+			depth:  1,
 		},
 		{
-			name:  "getting callstack: all = false",
-			stack: newCallStack(),
-			all:   false,
-			want:  []byte("goroutine"),
+			name:   "no filters",
+			tracer: NewTracer(0, nil),
+			first:  "github.com/zalgonoise/zlog/log/trace.captureFrom",
 		},
 	}
 
-	var verify = func(id int, test test, stack *stacktrace) {
-		if len(stack.buf) <= 0 {
-			t.Errorf(
-				"#%v -- FAILED -- [%s] [%s] -- empty buffer error: buffer is %v bytes in length -- action: %s",
-				id,
-				module,
-				funcname,
-				len(stack.buf),
-				test.name,
-			)
+	var verify = func(idx int, test test) {
+		stack := captureFrom(test.tracer, test.skip)
+
+		if len(stack) != 1 || stack[0].ID <= 0 || stack[0].Status != "running" || len(stack[0].Frames) == 0 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected goroutine: %v -- action: %s", idx, module, funcname, stack, test.name)
 			return
 		}
 
-		header := stack.buf[:9]
-		for idx, c := range header {
-			if c != test.want[idx] {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- character mismatch error: got %s ; wanted %s -- action: %s",
-					id,
-					module,
-					funcname,
-					string(test.want),
-					string(header),
-					test.name,
-				)
-				return
+		frames := stack[0].Frames
+
+		if frames[0].Function != test.first || !strings.HasSuffix(frames[0].File, "trace_test.go") || frames[0].Line == 0 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected first frame: wanted %s ; got %v -- action: %s", idx, module, funcname, test.first, frames[0], test.name)
+			return
+		}
+
+		if test.depth > 0 && len(frames) != test.depth {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v frames; got %v -- action: %s", idx, module, funcname, test.depth, len(frames), test.name)
+			return
+		}
+
+		for _, f := range frames {
+			for _, prefix := range test.denied {
+				if strings.HasPrefix(f.Function, prefix) {
+					t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected frame: %v -- action: %s", idx, module, funcname, f, test.name)
+					return
+				}
 			}
 		}
 
-		t.Logf(
-			"#%v -- PASSED -- [%s] [%s] -- action: %s",
-			id,
-			module,
-			funcname,
-			test.name,
-		)
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
 	}
 
-	for id, test := range tests {
-		stack := test.stack.getCallStack(test.all)
-
-		verify(id, test, stack)
+	for idx, test := range tests {
+		verify(idx, test)
 	}
+}
 
+func blockedGoroutine(ready chan<- struct{}, done <-chan struct{}) {
+	close(ready)
+	<-done
 }
 
-func TestSplitCallStack(t *testing.T) {
-	module := "Trace"
-	funcname := "splitCallStack()"
+func TestTracerCaptureAll(t *testing.T) {
+	module := "Tracer"
+	funcname := "CaptureAll()"
 
-	type test struct {
-		name     string
-		stack    *stacktrace
-		all      bool
-		minLines int
-		want     []byte
-	}
+	var (
+		ready = make(chan struct{})
+		done  = make(chan struct{})
+	)
 
-	var tests = []test{
-		{
-			name:     "splitting callstack: all = true",
-			stack:    newCallStack().getCallStack(true),
-			all:      true,
-			want:     []byte("goroutine"),
-			minLines: 21,
-		},
-		{
-			name:     "splitting callstack: all = false",
-			stack:    newCallStack().getCallStack(false),
-			all:      false,
-			want:     []byte("goroutine"),
-			minLines: 9,
-		},
-		{
-			name:     "splitting callstack: defaults",
-			stack:    newCallStack(),
-			all:      false,
-			want:     []byte("goroutine"),
-			minLines: 9,
-		},
-	}
+	go blockedGoroutine(ready, done)
+	defer close(done)
 
-	var verify = func(id int, test test, stack *stacktrace) {
-		if len(stack.buf) <= 0 {
-			t.Errorf(
-				"#%v -- FAILED -- [%s] [%s] -- empty buffer error: buffer is %v bytes in length -- action: %s",
-				id,
-				module,
-				funcname,
-				len(stack.buf),
-				test.name,
-			)
-			return
-		}
+	<-ready
 
-		if len(stack.split) < test.minLines {
-			t.Errorf(
-				"#%v -- FAILED -- [%s] [%s] -- buffer splitting issue: wanted %v lines ; got %v lines -- action: %s",
-				id,
-				module,
-				funcname,
-				test.minLines,
-				len(stack.split),
-				test.name,
-			)
-			return
-		}
+	stack := NewTracer(0, SkipRuntime).CaptureAll()
+
+	if len(stack) < 2 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected at least 2 goroutines; got %v -- action: %s", 0, module, funcname, len(stack), "all goroutines")
+		return
+	}
 
-		header := stack.split[0][:9]
-		for idx, c := range header {
-			if c != test.want[idx] {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- character mismatch error: got %s ; wanted %s -- action: %s",
-					id,
-					module,
-					funcname,
-					string(test.want),
-					string(header),
-					test.name,
-				)
+	for _, g := range stack {
+		for _, f := range g.Frames {
+			if f.Function == "github.com/zalgonoise/zlog/log/trace.blockedGoroutine" && g.Status == "chan receive" && f.Line > 0 {
+				t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "all goroutines")
 				return
 			}
 		}
-
-		t.Logf(
-			"#%v -- PASSED -- [%s] [%s] -- action: %s",
-			id,
-			module,
-			funcname,
-			test.name,
-		)
 	}
 
-	for id, test := range tests {
-		stack := test.stack.splitCallStack()
-
-		verify(id, test, stack)
-	}
+	t.Errorf("#%v -- FAILED -- [%s] [%s] blocked goroutine not found in the call stacks:\n%s -- action: %s", 1, module, funcname, stack, "all goroutines")
 }
 
-func TestParseCallStack(t *testing.T) {
+func TestParseStack(t *testing.T) {
 	module := "Trace"
-	funcname := "parseCallStack()"
-
-	type test struct {
-		name        string
-		stack       *stacktrace
-		all         bool
-		minRoutines int
-		minID       int
-		want        []string
-	}
-
-	var tests = []test{
+	funcname := "parseStack()"
+
+	dump := `goroutine 7 [running]:
+main.(*server).handle(0xc000010000, {0x1, 0x2})
+	/app/server.go:42 +0x1d
+main.main()
+	/app/main.go:12 +0x25
+
+goroutine 9 [chan receive, 2 minutes]:
+github.com/org/pkg.worker(...)
+	/go/pkg/worker.go:30
+...additional frames elided...
+created by main.main in goroutine 7
+	/app/main.go:10 +0x6b
+`
+
+	wants := Stack{
 		{
-			name:        "parsing callstack: all = true",
-			stack:       newCallStack().getCallStack(true).splitCallStack(),
-			all:         true,
-			minRoutines: 2,
-			minID:       1,
-			want:        []string{"running", "chan receive"},
+			ID:     7,
+			Status: "running",
+			Frames: []Frame{
+				{Function: "main.(*server).handle", File: "/app/server.go", Line: 42},
+				{Function: "main.main", File: "/app/main.go", Line: 12},
+			},
 		},
 		{
-			name:        "parsing callstack: all = false",
-			stack:       newCallStack().getCallStack(false).splitCallStack(),
-			all:         false,
-			minRoutines: 1,
-			minID:       1,
-			want:        []string{"running"},
-		},
-		{
-			name:        "parsing callstack: defaults",
-			stack:       newCallStack(),
-			all:         false,
-			minRoutines: 1,
-			minID:       1,
-			want:        []string{"running"},
+			ID:     9,
+			Status: "chan receive, 2 minutes",
+			Frames: []Frame{
+				{Function: "github.com/org/pkg.worker", File: "/go/pkg/worker.go", Line: 30},
+				{Function: "main.main", File: "/app/main.go", Line: 10},
+			},
 		},
 	}
 
-	var verify = func(id int, test test, stack *stacktrace) {
-		if len(stack.buf) <= 0 {
-			t.Errorf(
-				"#%v -- FAILED -- [%s] [%s] -- empty buffer error: buffer is %v bytes in length -- action: %s",
-				id,
-				module,
-				funcname,
-				len(stack.buf),
-				test.name,
-			)
-			return
-		}
-
-		if len(stack.stacks) < test.minRoutines {
-			t.Errorf(
-				"#%v -- FAILED -- [%s] [%s] -- parsed less goroutines than expected: wanted %v ; got %v -- aciton: %s",
-				id,
-				module,
-				funcname,
-				test.minRoutines,
-				len(stack.stacks),
-				test.name,
-			)
-			return
-		}
-
-		for idx, r := range stack.stacks {
-			id, err := strconv.Atoi(r.id)
-			if err != nil {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- failed to convert ID with error: %s -- action: %s",
-					id,
-					module,
-					funcname,
-					err,
-					test.name,
-				)
-				return
-			}
-
-			if id < test.minID {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- ID is lower than minimum: wanted > %v ; got %v -- action: %s",
-					id,
-					module,
-					funcname,
-					test.minID,
-					id,
-					test.name,
-				)
-				return
-			}
-
-			if r.status != test.want[idx] {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- status mismatch: wanted %s ; got %s -- action: %s",
-					id,
-					module,
-					funcname,
-					test.want,
-					r.status,
-					test.name,
-				)
-				return
-			}
-		}
-
-		t.Logf(
-			"#%v -- PASSED -- [%s] [%s] -- action: %s",
-			id,
-			module,
-			funcname,
-			test.name,
-		)
+	if got := parseStack([]byte(dump)); !reflect.DeepEqual(got, wants) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s", 0, module, funcname, wants, got, "parse stack dump")
+		return
 	}
 
-	for id, test := range tests {
-		stack := test.stack.parseCallStack()
-
-		verify(id, test, stack)
-	}
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "parse stack dump")
 }
 
-func TestMapCallStack(t *testing.T) {
+func TestFilters(t *testing.T) {
 	module := "Trace"
-	funcname := "mapCallStack()"
+	funcname := "Filter"
 
 	type test struct {
-		name        string
-		stack       *stacktrace
-		all         bool
-		minRoutines int
-		minID       int
-		want        []string
+		name   string
+		filter Filter
+		frame  Frame
+		wants  bool
 	}
 
 	var tests = []test{
 		{
-			name:        "mapping callstack: all = true",
-			stack:       newCallStack().getCallStack(true).splitCallStack().parseCallStack(),
-			all:         true,
-			minRoutines: 2,
-			minID:       1,
-			want:        []string{"running", "chan receive"},
+			name:   "runtime frame",
+			filter: SkipRuntime,
+			frame:  Frame{Function: "runtime.goexit", File: "/go/src/runtime/asm_amd64.s"},
+			wants:  true,
 		},
 		{
-			name:        "mapping callstack: all = false",
-			stack:       newCallStack().getCallStack(false).splitCallStack().parseCallStack(),
-			all:         false,
-			minRoutines: 1,
-			minID:       1,
-			want:        []string{"running"},
+			name:   "standard library frame",
+			filter: SkipStdlib,
+			frame:  Frame{Function: "net/http.(*conn).serve", File: "/go/src/net/http/server.go"},
+			wants:  true,
 		},
 		{
-			name:        "mapping callstack: defaults",
-			stack:       newCallStack(),
-			all:         false,
-			minRoutines: 1,
-			minID:       1,
-			want:        []string{"running"},
+			name:   "main package frame",
+			filter: SkipStdlib,
+			frame:  Frame{Function: "main.main", File: "/app/main.go"},
+		},
+		{
+			name:   "third-party frame",
+			filter: SkipStdlib,
+			frame:  Frame{Function: "github.com/org/pkg.(*T).Run", File: "/go/pkg/t.go"},
+		},
+		{
+			name:   "library frame",
+			filter: SkipZlog,
+			frame:  Frame{Function: "github.com/zalgonoise/zlog/log.(*logger).Info", File: "/zlog/log/print.go"},
+			wants:  true,
+		},
+		{
+			name:   "library test frame",
+			filter: SkipZlog,
+			frame:  Frame{Function: "github.com/zalgonoise/zlog/log.TestInfo", File: "/zlog/log/print_test.go"},
+		},
+		{
+			name:   "library example frame",
+			filter: SkipZlog,
+			frame:  Frame{Function: "github.com/zalgonoise/zlog/examples/logger/simple.main", File: "/zlog/examples/main.go"},
 		},
 	}
 
-	var verify = func(id int, test test, stack *stacktrace) {
-		if len(stack.buf) <= 0 {
-			t.Errorf(
-				"#%v -- FAILED -- [%s] [%s] -- empty buffer error: buffer is %v bytes in length -- action: %s",
-				id,
-				module,
-				funcname,
-				len(stack.buf),
-				test.name,
-			)
+	var verify = func(idx int, test test) {
+		if got := test.filter(test.frame); got != test.wants {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, got, test.name)
 			return
 		}
 
-		if len(stack.stacks) < test.minRoutines {
-			t.Errorf(
-				"#%v -- FAILED -- [%s] [%s] -- parsed less goroutines than expected: wanted %v ; got %v -- action: %s",
-				id,
-				module,
-				funcname,
-				test.minRoutines,
-				len(stack.stacks),
-				test.name,
-			)
-			return
-		}
-
-		for idx, r := range stack.stacks {
-			id, err := strconv.Atoi(r.id)
-			if err != nil {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- failed to convert ID with error: %s -- action: %s",
-					id,
-					module,
-					funcname,
-					err,
-					test.name,
-				)
-				return
-			}
-
-			if id < test.minID {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- ID is lower than minimum: wanted > %v ; got %v -- action: %s",
-					id,
-					module,
-					funcname,
-					test.minID,
-					id,
-					test.name,
-				)
-				return
-			}
-
-			if r.status != test.want[idx] {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- status mismatch: wanted %s ; got %s -- action: %s",
-					id,
-					module,
-					funcname,
-					test.want,
-					r.status,
-					test.name,
-				)
-				return
-			}
-
-			callmap := stack.out
-
-			routine, ok := callmap["goroutine-"+r.id]
-			if !ok {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- field not found in output object: expected key %s, wasn't found -- action: %s",
-					id,
-					module,
-					funcname,
-					"goroutine-"+r.id,
-					test.name,
-				)
-				return
-			}
-
-			if routine.(map[string]interface{})["id"] != r.id {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- ID field mismatch: expected %s, got %s -- action: %s",
-					id,
-					module,
-					funcname,
-					r.id,
-					routine.(map[string]interface{})["id"],
-					test.name,
-				)
-				return
-			}
-			if routine.(map[string]interface{})["status"] != test.want[idx] {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- status field mismatch: expected %s, got %s -- action: %s",
-					id,
-					module,
-					funcname,
-					test.want[idx],
-					routine.(map[string]interface{})["status"],
-					test.name,
-				)
-				return
-			}
-
-			stackmap, ok := routine.(map[string]interface{})["stack"]
-
-			if !ok {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- failed to retrieve stack map: expected key %s, wasn't found -- action: %s",
-					id,
-					module,
-					funcname,
-					"stack",
-					test.name,
-				)
-				return
-			}
-
-			for _, f := range stackmap.([]map[string]interface{}) {
-				if f["method"] == "" {
-					t.Errorf(
-						"#%v -- FAILED -- [%s] [%s] -- stack map method key is empty -- action: %s",
-						id,
-						module,
-						funcname,
-						test.name,
-					)
-					return
-				}
-				if f["reference"] == "" {
-					t.Errorf(
-						"#%v -- FAILED -- [%s] [%s] -- stack map reference key is empty -- action: %s",
-						id,
-						module,
-						funcname,
-						test.name,
-					)
-					return
-				}
-			}
-
-		}
-
-		t.Logf(
-			"#%v -- PASSED -- [%s] [%s] -- action: %s",
-			id,
-			module,
-			funcname,
-			test.name,
-		)
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
 	}
 
-	for id, test := range tests {
-		stack := test.stack.mapCallStack()
-
-		verify(id, test, stack)
+	for idx, test := range tests {
+		verify(idx, test)
 	}
 }
 
-func TestStacktraceAsMap(t *testing.T) {
+func TestFrames(t *testing.T) {
 	module := "Trace"
-	funcname := "asMap()"
+	funcname := "Frames()"
 
-	type test struct {
-		name        string
-		stack       *stacktrace
-		all         bool
-		minRoutines int
-		minID       int
-		want        []string
-	}
-
-	var tests = []test{
-		{
-			name:        "converting callstack map: all = true",
-			stack:       newCallStack().getCallStack(true).splitCallStack().parseCallStack().mapCallStack(),
-			all:         true,
-			minRoutines: 2,
-			minID:       1,
-			want:        []string{"running", "chan receive"},
-		},
-		{
-			name:        "converting callstack map: all = false",
-			stack:       newCallStack().getCallStack(false).splitCallStack().parseCallStack().mapCallStack(),
-			all:         false,
-			minRoutines: 1,
-			minID:       1,
-			want:        []string{"running"},
-		},
-		{
-			name:        "converting callstack map: defaults",
-			stack:       newCallStack(),
-			all:         false,
-			minRoutines: 1,
-			minID:       1,
-			want:        []string{"running"},
-		},
-	}
+	stack := NewTracer(1).Capture(0)
+	first := stack[0].Frames[0]
 
-	var verify = func(id int, test test, stack *stacktrace) {
-		callmap := stack.asMap()
-
-		for _, v := range callmap {
-			routine := v.(map[string]interface{})
-			fmt.Println(routine["id"])
-
-			if routine["id"] == nil || routine["id"] == "" {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- empty ID field -- action: %s",
-					id,
-					module,
-					funcname,
-					test.name,
-				)
-				return
-			}
+	// symbolized frames are cached, and the cached frames are not modified by further captures
+	for i := 0; i < 3; i++ {
+		again := NewTracer(1, func(Frame) bool { return false }).Capture(0)
 
-			var match bool
-			for _, s := range test.want {
-				if routine["status"] == s {
-					match = true
-					break
-				}
-			}
+		if again[0].Frames[0].Function != first.Function {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s", i, module, funcname, first, again[0].Frames[0], "cached frames")
+			return
+		}
+	}
 
-			if !match {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- status mismatch -- action: %s",
-					id,
-					module,
-					funcname,
-					test.name,
-				)
-				return
-			}
+	var cached int
 
-			if len(routine["stack"].([]map[string]interface{})) <= 0 {
-				t.Errorf(
-					"#%v -- FAILED -- [%s] [%s] -- call stack is unexpectedly empty -- action: %s",
-					id,
-					module,
-					funcname,
-					test.name,
-				)
-				return
-			}
-		}
+	frameCache.Range(func(_, _ interface{}) bool {
+		cached++
+		return true
+	})
 
-		t.Logf(
-			"#%v -- PASSED -- [%s] [%s] -- action: %s",
-			id,
-			module,
-			funcname,
-			test.name,
-		)
+	if cached == 0 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected cached frames -- action: %s", 3, module, funcname, "cached frames")
+		return
 	}
 
-	for id, test := range tests {
-		verify(id, test, test.stack)
-	}
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "cached frames")
 }
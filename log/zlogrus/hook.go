@@ -0,0 +1,123 @@
+// Package zlogrus routes github.com/sirupsen/logrus loggers into zlog, with a logrus.Hook that
+// writes their entries through a zlog log.Logger:
+//
+//	logger := log.New(log.WithFormat(log.FormatJSON))
+//
+//	l := logrus.New()
+//	l.SetOutput(io.Discard) // write only through zlog
+//	l.AddHook(zlogrus.NewHook(logger))
+//
+//	l.WithField("id", 42).Info("user logged in")
+//
+// Entries are converted into events, keeping their level, message, fields, timestamp and
+// caller; and written with the Logger's Output() method -- going through its filters, hooks,
+// formatter and outputs (files, databases or gRPC Log Clients)
+package zlogrus
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Hook struct is a logrus.Hook that writes logrus entries through a zlog log.Logger
+type Hook struct {
+	logger log.Logger
+	levels []logrus.Level
+}
+
+// NewHook function creates a logrus.Hook that writes the entries with the input levels through
+// the input log.Logger. If no levels are provided, entries of all levels are written.
+//
+// If the input log.Logger is nil, the standard Logger is used (writing to os.Stderr)
+func NewHook(logger log.Logger, levels ...logrus.Level) *Hook {
+	if logger == nil {
+		logger = log.New()
+	}
+
+	if len(levels) == 0 {
+		levels = logrus.AllLevels
+	}
+
+	return &Hook{
+		logger: logger,
+		levels: levels,
+	}
+}
+
+// Level function converts the input logrus.Level to an event.Level
+//
+// logrus still performs the panic or os.Exit(1) calls for Panic and Fatal entries, after they
+// are written
+func Level(level logrus.Level) event.Level {
+	switch level {
+	case logrus.PanicLevel:
+		return event.Level_panic
+	case logrus.FatalLevel:
+		return event.Level_fatal
+	case logrus.ErrorLevel:
+		return event.Level_error
+	case logrus.WarnLevel:
+		return event.Level_warn
+	case logrus.InfoLevel:
+		return event.Level_info
+	case logrus.DebugLevel:
+		return event.Level_debug
+	default:
+		return event.Level_trace
+	}
+}
+
+// Levels method implements logrus.Hook, returning the levels of the entries to write
+func (h *Hook) Levels() []logrus.Level {
+	return h.levels
+}
+
+// Fire method implements logrus.Hook.
+//
+// It converts the input logrus.Entry into an event.Event, and writes it with the log.Logger's
+// Output() method. Error values in the entry's fields are stored as their message, and the one
+// set with logrus' WithError() (or else the first one found) is also set as the event's error
+func (h *Hook) Fire(entry *logrus.Entry) error {
+	var (
+		meta map[string]interface{}
+		err  error
+	)
+
+	if len(entry.Data) > 0 {
+		meta = make(map[string]interface{}, len(entry.Data))
+
+		for k, v := range entry.Data {
+			if e, ok := v.(error); ok {
+				if err == nil || k == logrus.ErrorKey {
+					err = e
+				}
+
+				meta[k] = e.Error()
+				continue
+			}
+
+			meta[k] = v
+		}
+	}
+
+	b := event.New().
+		Level(Level(entry.Level)).
+		Message(entry.Message).
+		Metadata(meta).
+		Err(err)
+
+	if entry.Caller != nil {
+		b.BCaller = event.NewCallerFrom(entry.Caller.File, entry.Caller.Line, entry.Caller.Function)
+	}
+
+	m := b.Build()
+
+	if !entry.Time.IsZero() {
+		m.Time = timestamppb.New(entry.Time)
+	}
+
+	_, err = h.logger.Output(m)
+	return err
+}
@@ -0,0 +1,174 @@
+package zlogrus
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// capture function creates a Logger which stores the events it writes in the returned slice
+func capture(confs ...log.LoggerConfig) (log.Logger, *[]*event.Event) {
+	var events []*event.Event
+
+	confs = append(confs,
+		log.WithOut(new(bytes.Buffer)),
+		log.SkipExit,
+		log.WithHook(func(m *event.Event) bool {
+			events = append(events, m)
+			return true
+		}),
+	)
+
+	return log.New(confs...), &events
+}
+
+func TestLevel(t *testing.T) {
+	module := "zlogrus"
+	funcname := "Level()"
+
+	type test struct {
+		level logrus.Level
+		wants event.Level
+	}
+
+	var tests = []test{
+		{level: logrus.TraceLevel, wants: event.Level_trace},
+		{level: logrus.DebugLevel, wants: event.Level_debug},
+		{level: logrus.InfoLevel, wants: event.Level_info},
+		{level: logrus.WarnLevel, wants: event.Level_warn},
+		{level: logrus.ErrorLevel, wants: event.Level_error},
+		{level: logrus.FatalLevel, wants: event.Level_fatal},
+		{level: logrus.PanicLevel, wants: event.Level_panic},
+	}
+
+	for idx, test := range tests {
+		if level := Level(test.level); level != test.wants {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] level mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, level, test.level)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.level)
+	}
+}
+
+func TestFire(t *testing.T) {
+	module := "Hook"
+	funcname := "Fire()"
+
+	type test struct {
+		name   string
+		levels []logrus.Level
+		log    func(l *logrus.Logger)
+		level  event.Level
+		msg    string
+		meta   map[string]interface{}
+		err    bool
+		none   bool
+	}
+
+	var tests = []test{
+		{
+			name:  "simple entry",
+			log:   func(l *logrus.Logger) { l.Warn("null") },
+			level: event.Level_warn,
+			msg:   "null",
+		},
+		{
+			name:  "entry with fields",
+			log:   func(l *logrus.Logger) { l.WithFields(logrus.Fields{"a": true, "id": int64(1<<62 + 1)}).Info("null") },
+			level: event.Level_info,
+			msg:   "null",
+			meta:  map[string]interface{}{"a": true, "id": int64(1<<62 + 1)},
+		},
+		{
+			name:  "entry with an error",
+			log:   func(l *logrus.Logger) { l.WithError(errors.New("not found")).Error("failed") },
+			level: event.Level_error,
+			msg:   "failed",
+			meta:  map[string]interface{}{"error": "not found"},
+			err:   true,
+		},
+		{
+			name:   "entry with a level out of the hook's levels",
+			levels: []logrus.Level{logrus.ErrorLevel},
+			log:    func(l *logrus.Logger) { l.Info("null") },
+			none:   true,
+		},
+	}
+
+	for idx, test := range tests {
+		logger, events := capture()
+
+		l := logrus.New()
+		l.SetOutput(io.Discard)
+		l.AddHook(NewHook(logger, test.levels...))
+
+		test.log(l)
+
+		if test.none {
+			if len(*events) != 0 {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] expected no events; got %v -- action: %s", idx, module, funcname, len(*events), test.name)
+				continue
+			}
+
+			t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+			continue
+		}
+
+		if len(*events) != 1 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected one event; got %v -- action: %s", idx, module, funcname, len(*events), test.name)
+			continue
+		}
+
+		m := (*events)[0]
+
+		if m.GetLevel() != test.level || m.GetMsg() != test.msg {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] event mismatch: wanted [%s] %s ; got [%s] %s -- action: %s", idx, module, funcname, test.level, test.msg, m.GetLevel(), m.GetMsg(), test.name)
+			continue
+		}
+
+		if len(test.meta) > 0 && !reflect.DeepEqual(m.Metadata(), test.meta) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] metadata mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.meta, m.Metadata(), test.name)
+			continue
+		}
+
+		if (m.GetError() != nil) != test.err {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.err, m.GetError(), test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
+
+func TestCaller(t *testing.T) {
+	module := "zlogrus"
+	funcname := "Fire()"
+
+	logger, events := capture(log.WithCaller())
+	l := logrus.New()
+	l.SetOutput(io.Discard)
+	l.AddHook(NewHook(logger))
+
+	_, _, line, _ := runtime.Caller(0)
+	l.Info("null")
+
+	if len(*events) != 1 {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] expected a single event; got %v -- action: %s", 0, module, funcname, len(*events), "skip the logrus and adapter frames")
+	}
+
+	if c := (*events)[0].GetCaller(); !strings.HasSuffix(c.GetFile(), "hook_test.go") || c.GetLine() != int32(line+1) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] location mismatch: wanted hook_test.go:%v ; got %s -- action: %s", 0, module, funcname, line+1, c.Short(), "skip the logrus and adapter frames")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "skip the logrus and adapter frames")
+}
@@ -0,0 +1,336 @@
+//go:build go1.21
+
+// Package zslog bridges the standard library's log/slog API and zlog Loggers.
+//
+// It provides a slog.Handler which writes slog records through any zlog log.Logger (including
+// MultiLoggers, gRPC Log Clients and Loggers writing to a store/db backend), and a shortcut to
+// expose a zlog log.Logger as a *slog.Logger:
+//
+//	logger := log.New(log.WithFormat(log.FormatJSON))
+//
+//	slogger := zslog.New(logger)
+//	slogger.Info("user logged in", "id", 42)
+//
+// slog levels are mapped to the closest event.Level (see Level()); slog groups become nested
+// metadata maps, and attributes added with (*slog.Logger).With() become logger-scoped fields
+// in a child Logger (see log.Logger's Child() method)
+package zslog
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler struct is a slog.Handler that writes slog records through a zlog log.Logger
+type Handler struct {
+	base   log.Logger
+	logger log.Logger
+	opts   slog.HandlerOptions
+	fields map[string]interface{}
+	groups []string
+}
+
+// NewHandler function creates a slog.Handler that writes through the input log.Logger, configured
+// with the input slog.HandlerOptions (if not nil):
+//   - AddSource sets each event's caller from the record's program counter;
+//   - Level sets a minimum level on top of the log.Logger's own level filter;
+//   - ReplaceAttr is called on each (non-group) attribute before it is added to the metadata.
+//
+// If the input log.Logger is nil, the standard Logger is used (writing to os.Stderr)
+func NewHandler(logger log.Logger, opts *slog.HandlerOptions) *Handler {
+	if logger == nil {
+		logger = log.New()
+	}
+
+	h := &Handler{
+		base:   logger,
+		logger: logger,
+	}
+
+	if opts != nil {
+		h.opts = *opts
+	}
+
+	return h
+}
+
+// New function returns a *slog.Logger which writes through the input log.Logger
+func New(logger log.Logger) *slog.Logger {
+	return slog.New(NewHandler(logger, nil))
+}
+
+// Level function converts the input slog.Level to the closest event.Level:
+//
+//	slog level       | event.Level
+//	below Debug      | trace
+//	Debug to Info    | debug
+//	Info to Warn     | info
+//	Warn to Error    | warn
+//	Error and above  | error
+func Level(level slog.Level) event.Level {
+	switch {
+	case level < slog.LevelDebug:
+		return event.Level_trace
+	case level < slog.LevelInfo:
+		return event.Level_debug
+	case level < slog.LevelWarn:
+		return event.Level_info
+	case level < slog.LevelError:
+		return event.Level_warn
+	default:
+		return event.Level_error
+	}
+}
+
+// Enabled method implements slog.Handler.
+//
+// It reports whether a record with the input level would be written, considering the handler's
+// minimum level (if set) and the log.Logger's level filter (if it implements log.Leveler)
+func (h *Handler) Enabled(_ context.Context, level slog.Level) bool {
+	if h.opts.Level != nil && level < h.opts.Level.Level() {
+		return false
+	}
+
+	if lv, ok := h.logger.(log.Leveler); ok {
+		return Level(level).Int() >= lv.Level().Int()
+	}
+
+	return true
+}
+
+// Handle method implements slog.Handler.
+//
+// It converts the input slog.Record into an event.Event and writes it with the log.Logger's
+// Output() method. The first error value found in the record's attributes is also set as the
+// event's error
+func (h *Handler) Handle(_ context.Context, r slog.Record) error {
+	var (
+		logger = h.logger
+		meta   map[string]interface{}
+		err    error
+	)
+
+	// within a group, the record's attributes are nested in the same maps as the handler's
+	// fields; which are merged here, as the Logger's fields would replace them
+	if len(h.groups) > 0 && len(h.fields) > 0 {
+		logger = h.base
+		meta = copyMap(h.fields)
+	}
+
+	if r.NumAttrs() > 0 {
+		if meta == nil {
+			meta = map[string]interface{}{}
+		}
+
+		scope := h.scope(meta)
+
+		r.Attrs(func(a slog.Attr) bool {
+			if e, ok := a.Value.Resolve().Any().(error); ok && err == nil {
+				err = e
+			}
+
+			h.add(scope, h.groups, a)
+			return true
+		})
+
+		prune(meta, h.groups)
+	}
+
+	b := event.New().
+		Level(Level(r.Level)).
+		Message(r.Message).
+		Metadata(meta).
+		Err(err)
+
+	if h.opts.AddSource && r.PC != 0 {
+		frames := runtime.CallersFrames([]uintptr{r.PC})
+		f, _ := frames.Next()
+
+		b.BCaller = event.NewCallerFrom(f.File, f.Line, f.Function)
+	}
+
+	m := b.Build()
+
+	if !r.Time.IsZero() {
+		m.Time = timestamppb.New(r.Time)
+	}
+
+	_, err = logger.Output(m)
+	return err
+}
+
+// WithAttrs method implements slog.Handler.
+//
+// It returns a new Handler writing to a child of the log.Logger, with the input attributes set as
+// logger-scoped fields (nested under the handler's groups, if any)
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	fields := copyMap(h.fields)
+	scope := h.scope(fields)
+
+	for _, a := range attrs {
+		h.add(scope, h.groups, a)
+	}
+
+	prune(fields, h.groups)
+
+	child := h.clone()
+	child.fields = fields
+	child.logger = h.base.With(fields)
+
+	return child
+}
+
+// WithGroup method implements slog.Handler.
+//
+// It returns a new Handler which nests the attributes added afterwards (both with WithAttrs()
+// and in records) under the input group name, as a metadata map
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	child := h.clone()
+	child.groups = append(child.groups[:len(child.groups):len(child.groups)], name)
+
+	return child
+}
+
+func (h *Handler) clone() *Handler {
+	return &Handler{
+		base:   h.base,
+		logger: h.logger,
+		opts:   h.opts,
+		fields: h.fields,
+		groups: h.groups,
+	}
+}
+
+// scope method returns the map, within the input metadata, where attributes are added for the
+// handler's current group; creating (or copying) the nested group maps as needed
+func (h *Handler) scope(meta map[string]interface{}) map[string]interface{} {
+	for _, g := range h.groups {
+		inner, ok := meta[g].(map[string]interface{})
+
+		if !ok {
+			inner = map[string]interface{}{}
+		} else {
+			inner = copyMap(inner)
+		}
+
+		meta[g] = inner
+		meta = inner
+	}
+
+	return meta
+}
+
+// add method sets the input slog.Attr in the input metadata map, resolving its value and
+// nesting groups as maps. Empty attributes are ignored, and groups with an empty key are inlined
+func (h *Handler) add(meta map[string]interface{}, groups []string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+
+	if a.Value.Kind() != slog.KindGroup && h.opts.ReplaceAttr != nil {
+		a = h.opts.ReplaceAttr(groups, a)
+		a.Value = a.Value.Resolve()
+	}
+
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+
+	if a.Value.Kind() != slog.KindGroup {
+		meta[a.Key] = value(a.Value)
+		return
+	}
+
+	attrs := a.Value.Group()
+
+	if len(attrs) == 0 {
+		return
+	}
+
+	if a.Key == "" {
+		for _, inner := range attrs {
+			h.add(meta, groups, inner)
+		}
+		return
+	}
+
+	group, ok := meta[a.Key].(map[string]interface{})
+	if !ok {
+		group = map[string]interface{}{}
+	} else {
+		group = copyMap(group)
+	}
+
+	meta[a.Key] = group
+
+	for _, inner := range attrs {
+		h.add(group, append(groups[:len(groups):len(groups)], a.Key), inner)
+	}
+}
+
+// value function converts a (resolved, non-group) slog.Value into a metadata value
+func value(v slog.Value) interface{} {
+	switch v.Kind() {
+	case slog.KindBool:
+		return v.Bool()
+	case slog.KindDuration:
+		return v.Duration()
+	case slog.KindFloat64:
+		return v.Float64()
+	case slog.KindInt64:
+		return v.Int64()
+	case slog.KindUint64:
+		return v.Uint64()
+	case slog.KindString:
+		return v.String()
+	case slog.KindTime:
+		return v.Time()
+	}
+
+	switch t := v.Any().(type) {
+	case error:
+		return t.Error()
+	default:
+		return t
+	}
+}
+
+// prune function removes the (nested) group maps in the input metadata which are left empty,
+// as slog omits empty groups
+func prune(meta map[string]interface{}, groups []string) {
+	if len(groups) == 0 {
+		return
+	}
+
+	inner, ok := meta[groups[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	prune(inner, groups[1:])
+
+	if len(inner) == 0 {
+		delete(meta, groups[0])
+	}
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+
+	for k, v := range m {
+		out[k] = v
+	}
+
+	return out
+}
@@ -0,0 +1,271 @@
+//go:build go1.21
+
+package zslog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/slogtest"
+	"time"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// capture function creates a Logger which stores the events it writes in the returned slice
+func capture(confs ...log.LoggerConfig) (log.Logger, *[]*event.Event) {
+	var events []*event.Event
+
+	confs = append(confs,
+		log.WithOut(new(bytes.Buffer)),
+		log.SkipExit,
+		log.WithHook(func(m *event.Event) bool {
+			events = append(events, m)
+			return true
+		}),
+	)
+
+	return log.New(confs...), &events
+}
+
+func TestSlogtest(t *testing.T) {
+	var events *[]*event.Event
+
+	slogtest.Run(t,
+		func(t *testing.T) slog.Handler {
+			// events always carry a timestamp
+			if strings.Contains(t.Name(), "zero-time") {
+				t.Skip("zlog events are always timestamped")
+			}
+
+			var logger log.Logger
+			logger, events = capture()
+
+			return NewHandler(logger, nil)
+		},
+		func(t *testing.T) map[string]any {
+			if len(*events) != 1 {
+				t.Fatalf("expected one event; got %v", len(*events))
+			}
+
+			m := (*events)[0]
+			out := m.Metadata()
+
+			out[slog.TimeKey] = m.GetTime().AsTime()
+			out[slog.LevelKey] = m.GetLevel().String()
+			out[slog.MessageKey] = m.GetMsg()
+
+			return out
+		},
+	)
+}
+
+func TestLevel(t *testing.T) {
+	module := "zslog"
+	funcname := "Level()"
+
+	type test struct {
+		level slog.Level
+		wants event.Level
+	}
+
+	var tests = []test{
+		{level: slog.LevelDebug - 4, wants: event.Level_trace},
+		{level: slog.LevelDebug, wants: event.Level_debug},
+		{level: slog.LevelDebug + 1, wants: event.Level_debug},
+		{level: slog.LevelInfo, wants: event.Level_info},
+		{level: slog.LevelWarn, wants: event.Level_warn},
+		{level: slog.LevelError, wants: event.Level_error},
+		{level: slog.LevelError + 4, wants: event.Level_error},
+	}
+
+	for idx, test := range tests {
+		if level := Level(test.level); level != test.wants {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] level mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, level, test.level)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.level)
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	module := "Handler"
+	funcname := "Enabled()"
+
+	type test struct {
+		name   string
+		filter event.Level
+		opts   *slog.HandlerOptions
+		level  slog.Level
+		ok     bool
+	}
+
+	var tests = []test{
+		{
+			name:   "info record with an info filter",
+			filter: event.Level_info,
+			level:  slog.LevelInfo,
+			ok:     true,
+		},
+		{
+			name:   "debug record with an info filter",
+			filter: event.Level_info,
+			level:  slog.LevelDebug,
+		},
+		{
+			name:   "info record with a warn handler level",
+			filter: event.Level_trace,
+			opts:   &slog.HandlerOptions{Level: slog.LevelWarn},
+			level:  slog.LevelInfo,
+		},
+		{
+			name:   "error record with a warn handler level",
+			filter: event.Level_trace,
+			opts:   &slog.HandlerOptions{Level: slog.LevelWarn},
+			level:  slog.LevelError,
+			ok:     true,
+		},
+	}
+
+	for idx, test := range tests {
+		logger, _ := capture(log.WithFilter(test.filter))
+
+		if ok := NewHandler(logger, test.opts).Enabled(context.Background(), test.level); ok != test.ok {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] output mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.ok, ok, test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
+
+func TestHandle(t *testing.T) {
+	module := "Handler"
+	funcname := "Handle()"
+
+	type test struct {
+		name  string
+		log   func(l *slog.Logger)
+		level event.Level
+		msg   string
+		meta  map[string]interface{}
+		err   bool
+	}
+
+	var tests = []test{
+		{
+			name:  "simple record",
+			log:   func(l *slog.Logger) { l.Warn("null", "a", true) },
+			level: event.Level_warn,
+			msg:   "null",
+			meta:  map[string]interface{}{"a": true},
+		},
+		{
+			name:  "lossless values",
+			log:   func(l *slog.Logger) { l.Info("null", "id", int64(1<<62+1), "elapsed", time.Second) },
+			level: event.Level_info,
+			msg:   "null",
+			meta:  map[string]interface{}{"id": int64(1<<62 + 1), "elapsed": time.Second},
+		},
+		{
+			name:  "logger-scoped fields within groups",
+			log:   func(l *slog.Logger) { l.With("a", 1).WithGroup("req").With("b", 2).Info("null", "c", 3) },
+			level: event.Level_info,
+			msg:   "null",
+			meta: map[string]interface{}{
+				"a":   int64(1),
+				"req": map[string]interface{}{"b": int64(2), "c": int64(3)},
+			},
+		},
+		{
+			name:  "error value",
+			log:   func(l *slog.Logger) { l.Error("failed", "err", errors.New("not found")) },
+			level: event.Level_error,
+			msg:   "failed",
+			meta:  map[string]interface{}{"err": "not found"},
+			err:   true,
+		},
+	}
+
+	for idx, test := range tests {
+		logger, events := capture()
+
+		test.log(New(logger))
+
+		if len(*events) != 1 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected one event; got %v -- action: %s", idx, module, funcname, len(*events), test.name)
+			continue
+		}
+
+		m := (*events)[0]
+
+		if m.GetLevel() != test.level || m.GetMsg() != test.msg {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] event mismatch: wanted [%s] %s ; got [%s] %s -- action: %s", idx, module, funcname, test.level, test.msg, m.GetLevel(), m.GetMsg(), test.name)
+			continue
+		}
+
+		if !reflect.DeepEqual(m.Metadata(), test.meta) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] metadata mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.meta, m.Metadata(), test.name)
+			continue
+		}
+
+		if (m.GetError() != nil) != test.err {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.err, m.GetError(), test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
+
+func TestWithAttrs(t *testing.T) {
+	module := "Handler"
+	funcname := "WithAttrs()"
+
+	logger, events := capture()
+	parent := New(logger)
+
+	parent.With("a", 1).Info("null")
+	parent.Info("null")
+
+	if len(*events) != 2 {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] expected two events; got %v", 0, module, funcname, len(*events))
+	}
+
+	if meta := (*events)[0].Metadata(); !reflect.DeepEqual(meta, map[string]interface{}{"a": int64(1)}) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] metadata mismatch: got %v -- action: %s", 0, module, funcname, meta, "set logger-scoped fields")
+	}
+
+	if meta := (*events)[1].Metadata(); len(meta) > 0 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] parent was modified: got %v -- action: %s", 1, module, funcname, meta, "keep the parent's fields")
+	}
+}
+
+func TestCaller(t *testing.T) {
+	module := "zslog"
+	funcname := "Handle()"
+
+	logger, events := capture(log.WithCaller())
+	l := slog.New(NewHandler(logger, nil))
+
+	_, _, line, _ := runtime.Caller(0)
+	l.Info("null")
+
+	if len(*events) != 1 {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] expected a single event; got %v -- action: %s", 0, module, funcname, len(*events), "skip the slog and adapter frames")
+	}
+
+	if c := (*events)[0].GetCaller(); !strings.HasSuffix(c.GetFile(), "handler_test.go") || c.GetLine() != int32(line+1) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] location mismatch: wanted handler_test.go:%v ; got %s -- action: %s", 0, module, funcname, line+1, c.Short(), "skip the slog and adapter frames")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "skip the slog and adapter frames")
+}
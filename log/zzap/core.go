@@ -0,0 +1,173 @@
+// Package zzap routes go.uber.org/zap loggers into zlog, with a zapcore.Core that writes its
+// entries through a zlog log.Logger:
+//
+//	logger := log.New(log.WithFormat(log.FormatJSON))
+//
+//	z := zap.New(zzap.NewCore(logger))
+//	z.Info("user logged in", zap.Int("id", 42))
+//
+// Entries are converted into events, keeping their level, message, fields, timestamp and
+// caller; and written with the Logger's Output() method -- going through its filters, hooks,
+// formatter and outputs (files, databases or gRPC Log Clients)
+package zzap
+
+import (
+	"context"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Core struct is a zapcore.Core that writes zap entries through a zlog log.Logger
+type Core struct {
+	logger log.Logger
+}
+
+// NewCore function creates a zapcore.Core that writes through the input log.Logger.
+//
+// If the input log.Logger is nil, the standard Logger is used (writing to os.Stderr)
+func NewCore(logger log.Logger) *Core {
+	if logger == nil {
+		logger = log.New()
+	}
+
+	return &Core{
+		logger: logger,
+	}
+}
+
+// Level function converts the input zapcore.Level to an event.Level. DPanic entries are
+// written as errors.
+//
+// zap still performs the panic or os.Exit(1) calls for Panic and Fatal entries, after they
+// are written
+func Level(level zapcore.Level) event.Level {
+	switch level {
+	case zapcore.DebugLevel:
+		return event.Level_debug
+	case zapcore.InfoLevel:
+		return event.Level_info
+	case zapcore.WarnLevel:
+		return event.Level_warn
+	case zapcore.ErrorLevel, zapcore.DPanicLevel:
+		return event.Level_error
+	case zapcore.PanicLevel:
+		return event.Level_panic
+	case zapcore.FatalLevel:
+		return event.Level_fatal
+	default:
+		if level < zapcore.DebugLevel {
+			return event.Level_trace
+		}
+		return event.Level_error
+	}
+}
+
+// Enabled method implements zapcore.LevelEnabler.
+//
+// It reports whether an entry with the input level would be written, considering the
+// log.Logger's level filter (if it implements log.Leveler)
+func (c *Core) Enabled(level zapcore.Level) bool {
+	if lv, ok := c.logger.(log.Leveler); ok {
+		return Level(level).Int() >= lv.Level().Int()
+	}
+
+	return true
+}
+
+// With method implements zapcore.Core.
+//
+// It returns a new Core writing to a child of the log.Logger, with the input fields set as
+// logger-scoped fields
+func (c *Core) With(fields []zapcore.Field) zapcore.Core {
+	if len(fields) == 0 {
+		return c
+	}
+
+	enc := zapcore.NewMapObjectEncoder()
+
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+
+	return &Core{
+		logger: c.logger.With(enc.Fields),
+	}
+}
+
+// Check method implements zapcore.Core, adding the Core to the input zapcore.CheckedEntry if
+// the entry's level is enabled
+func (c *Core) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+
+	return ce
+}
+
+// Write method implements zapcore.Core.
+//
+// It converts the input zapcore.Entry and its fields into an event.Event, and writes it with
+// the log.Logger's Output() method. The entry's logger name (if set) is used as the event's
+// sub-prefix, and its stack trace (if any) is added to the metadata as `stacktrace`. The first
+// error field is also set as the event's error
+func (c *Core) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	var (
+		meta map[string]interface{}
+		err  error
+	)
+
+	if len(fields) > 0 || entry.Stack != "" {
+		enc := zapcore.NewMapObjectEncoder()
+
+		for _, f := range fields {
+			f.AddTo(enc)
+
+			if e, ok := f.Interface.(error); ok && f.Type == zapcore.ErrorType && err == nil {
+				err = e
+			}
+		}
+
+		if entry.Stack != "" {
+			enc.Fields["stacktrace"] = entry.Stack
+		}
+
+		meta = enc.Fields
+	}
+
+	b := event.New().
+		Level(Level(entry.Level)).
+		Sub(entry.LoggerName).
+		Message(entry.Message).
+		Metadata(meta).
+		Err(err)
+
+	if entry.Caller.Defined {
+		b.BCaller = event.NewCallerFrom(entry.Caller.File, entry.Caller.Line, entry.Caller.Function)
+	}
+
+	m := b.Build()
+
+	if !entry.Time.IsZero() {
+		m.Time = timestamppb.New(entry.Time)
+	}
+
+	_, err = c.logger.Output(m)
+	return err
+}
+
+// Sync method implements zapcore.Core.
+//
+// It flushes the log.Logger's asynchronous queue (if it is a log.AsyncLogger), and then syncs
+// its outputs, such as buffered writers and logfiles
+func (c *Core) Sync() error {
+	if a, ok := c.logger.(log.AsyncLogger); ok {
+		if err := a.Flush(context.Background()); err != nil {
+			return err
+		}
+	}
+
+	return c.logger.Sync()
+}
@@ -0,0 +1,229 @@
+package zzap
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// capture function creates a Logger which stores the events it writes in the returned slice
+func capture(confs ...log.LoggerConfig) (log.Logger, *[]*event.Event) {
+	var events []*event.Event
+
+	confs = append(confs,
+		log.WithOut(new(bytes.Buffer)),
+		log.SkipExit,
+		log.WithHook(func(m *event.Event) bool {
+			events = append(events, m)
+			return true
+		}),
+	)
+
+	return log.New(confs...), &events
+}
+
+func TestLevel(t *testing.T) {
+	module := "zzap"
+	funcname := "Level()"
+
+	type test struct {
+		level zapcore.Level
+		wants event.Level
+	}
+
+	var tests = []test{
+		{level: zapcore.DebugLevel - 1, wants: event.Level_trace},
+		{level: zapcore.DebugLevel, wants: event.Level_debug},
+		{level: zapcore.InfoLevel, wants: event.Level_info},
+		{level: zapcore.WarnLevel, wants: event.Level_warn},
+		{level: zapcore.ErrorLevel, wants: event.Level_error},
+		{level: zapcore.DPanicLevel, wants: event.Level_error},
+		{level: zapcore.PanicLevel, wants: event.Level_panic},
+		{level: zapcore.FatalLevel, wants: event.Level_fatal},
+	}
+
+	for idx, test := range tests {
+		if level := Level(test.level); level != test.wants {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] level mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, level, test.level)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.level)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	module := "Core"
+	funcname := "Write()"
+
+	type test struct {
+		name  string
+		log   func(l *zap.Logger)
+		level event.Level
+		sub   string
+		msg   string
+		meta  map[string]interface{}
+		err   bool
+	}
+
+	var tests = []test{
+		{
+			name:  "simple entry",
+			log:   func(l *zap.Logger) { l.Warn("null", zap.Bool("a", true)) },
+			level: event.Level_warn,
+			msg:   "null",
+			meta:  map[string]interface{}{"a": true},
+		},
+		{
+			name:  "lossless values",
+			log:   func(l *zap.Logger) { l.Info("null", zap.Int64("id", 1<<62+1), zap.Duration("elapsed", time.Second)) },
+			level: event.Level_info,
+			msg:   "null",
+			meta:  map[string]interface{}{"id": int64(1<<62 + 1), "elapsed": time.Second},
+		},
+		{
+			name:  "named logger with fields",
+			log:   func(l *zap.Logger) { l.Named("db").With(zap.String("a", "b")).Info("null", zap.Int("c", 1)) },
+			level: event.Level_info,
+			sub:   "db",
+			msg:   "null",
+			meta:  map[string]interface{}{"a": "b", "c": int64(1)},
+		},
+		{
+			name:  "error field",
+			log:   func(l *zap.Logger) { l.Error("failed", zap.Error(errors.New("not found"))) },
+			level: event.Level_error,
+			msg:   "failed",
+			meta:  map[string]interface{}{"error": "not found"},
+			err:   true,
+		},
+	}
+
+	for idx, test := range tests {
+		logger, events := capture()
+
+		test.log(zap.New(NewCore(logger)))
+
+		if len(*events) != 1 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected one event; got %v -- action: %s", idx, module, funcname, len(*events), test.name)
+			continue
+		}
+
+		m := (*events)[0]
+
+		if m.GetLevel() != test.level || m.GetSub() != test.sub || m.GetMsg() != test.msg {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] event mismatch: wanted [%s] [%s] %s ; got [%s] [%s] %s -- action: %s", idx, module, funcname, test.level, test.sub, test.msg, m.GetLevel(), m.GetSub(), m.GetMsg(), test.name)
+			continue
+		}
+
+		if !reflect.DeepEqual(m.Metadata(), test.meta) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] metadata mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.meta, m.Metadata(), test.name)
+			continue
+		}
+
+		if (m.GetError() != nil) != test.err {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.err, m.GetError(), test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	module := "Core"
+	funcname := "Enabled()"
+
+	logger, events := capture(log.WithFilter(event.Level_warn))
+	z := zap.New(NewCore(logger))
+
+	z.Info("null")
+	z.Warn("null")
+
+	if len(*events) != 1 || (*events)[0].GetLevel() != event.Level_warn {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a single warn event; got %v -- action: %s", 0, module, funcname, *events, "apply the Logger's level filter")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "apply the Logger's level filter")
+}
+
+type testSyncer struct {
+	bytes.Buffer
+	synced int
+}
+
+func (w *testSyncer) Sync() error {
+	w.synced++
+	return nil
+}
+
+func TestSync(t *testing.T) {
+	module := "zzap"
+	funcname := "Sync()"
+
+	type test struct {
+		name  string
+		confs []log.LoggerConfig
+	}
+
+	var tests = []test{
+		{
+			name: "synchronous logger",
+		},
+		{
+			name:  "asynchronous logger",
+			confs: []log.LoggerConfig{log.WithAsync(16, log.OverflowBlock)},
+		},
+	}
+
+	for idx, test := range tests {
+		out := new(testSyncer)
+		z := zap.New(NewCore(log.New(append(test.confs, log.WithOut(out), log.CfgTextOnly)...)))
+
+		z.Info("null")
+
+		if err := z.Sync(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, err, test.name)
+			continue
+		}
+
+		if out.synced != 1 || out.String() != "null\n" {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected the output to be written and synced once; got %q and %v syncs -- action: %s", idx, module, funcname, out.String(), out.synced, test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
+
+func TestCaller(t *testing.T) {
+	module := "zzap"
+	funcname := "Write()"
+
+	logger, events := capture(log.WithCaller())
+	z := zap.New(NewCore(logger))
+
+	_, _, line, _ := runtime.Caller(0)
+	z.Info("null")
+
+	if len(*events) != 1 {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] expected a single event; got %v -- action: %s", 0, module, funcname, len(*events), "skip the zap and adapter frames")
+	}
+
+	if c := (*events)[0].GetCaller(); !strings.HasSuffix(c.GetFile(), "core_test.go") || c.GetLine() != int32(line+1) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] location mismatch: wanted core_test.go:%v ; got %s -- action: %s", 0, module, funcname, line+1, c.Short(), "skip the zap and adapter frames")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "skip the zap and adapter frames")
+}
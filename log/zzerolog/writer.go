@@ -0,0 +1,227 @@
+// Package zzerolog routes github.com/rs/zerolog loggers into zlog, with a zerolog.LevelWriter
+// that writes their (JSON) output through a zlog log.Logger:
+//
+//	logger := log.New(log.WithFormat(log.FormatJSON))
+//
+//	z := zerolog.New(zzerolog.NewWriter(logger)).With().Timestamp().Logger()
+//	z.Info().Int("id", 42).Msg("user logged in")
+//
+// Each JSON object written by zerolog is decoded into an event, where its level, message,
+// timestamp, caller and error fields are set as the event's own; and the remaining fields as its
+// metadata. The event is written with the Logger's Output() method -- going through its filters,
+// hooks, formatter and outputs (files, databases or gRPC Log Clients)
+package zzerolog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidObject error = errors.New("invalid input -- must be a JSON object")
+)
+
+// Writer struct is a zerolog.LevelWriter that writes zerolog's output through a zlog log.Logger
+type Writer struct {
+	logger log.Logger
+}
+
+// NewWriter function creates a zerolog.LevelWriter that writes through the input log.Logger.
+//
+// If the input log.Logger is nil, the standard Logger is used (writing to os.Stderr)
+func NewWriter(logger log.Logger) *Writer {
+	if logger == nil {
+		logger = log.New()
+	}
+
+	return &Writer{
+		logger: logger,
+	}
+}
+
+// Level function converts the input zerolog.Level to an event.Level. Events without a level
+// (zerolog.NoLevel) are set with the default level (info)
+//
+// zerolog still performs the panic or os.Exit(1) calls for Panic and Fatal events, after they
+// are written
+func Level(level zerolog.Level) event.Level {
+	switch level {
+	case zerolog.TraceLevel:
+		return event.Level_trace
+	case zerolog.DebugLevel:
+		return event.Level_debug
+	case zerolog.InfoLevel:
+		return event.Level_info
+	case zerolog.WarnLevel:
+		return event.Level_warn
+	case zerolog.ErrorLevel:
+		return event.Level_error
+	case zerolog.FatalLevel:
+		return event.Level_fatal
+	case zerolog.PanicLevel:
+		return event.Level_panic
+	default:
+		if level < zerolog.TraceLevel {
+			return event.Level_trace
+		}
+		return event.Default_Event_Level
+	}
+}
+
+// Write method implements io.Writer, reading the event's level from its level field
+func (w *Writer) Write(p []byte) (n int, err error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+// WriteLevel method implements zerolog.LevelWriter.
+//
+// It decodes the input JSON object into an event.Event and writes it with the log.Logger's
+// Output() method. If the input level is zerolog.NoLevel, the one in the object's level field
+// is used instead. Disabled events are ignored.
+//
+// Integer fields are kept as int64 values, and the error field (if set) is also set as the
+// event's error
+func (w *Writer) WriteLevel(level zerolog.Level, p []byte) (n int, err error) {
+	if level == zerolog.Disabled {
+		return len(p), nil
+	}
+
+	var fields map[string]interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(p))
+	dec.UseNumber()
+
+	if err = dec.Decode(&fields); err != nil || fields == nil {
+		return 0, ErrInvalidObject
+	}
+
+	b := event.New()
+
+	if lv, ok := fields[zerolog.LevelFieldName].(string); ok {
+		if level == zerolog.NoLevel {
+			level, _ = zerolog.ParseLevel(lv)
+		}
+		delete(fields, zerolog.LevelFieldName)
+	}
+
+	if level == zerolog.Disabled {
+		return len(p), nil
+	}
+
+	b.Level(Level(level))
+
+	if msg, ok := fields[zerolog.MessageFieldName].(string); ok {
+		b.Message(msg)
+		delete(fields, zerolog.MessageFieldName)
+	}
+
+	if c, ok := fields[zerolog.CallerFieldName].(string); ok {
+		if caller := parseCaller(c); caller != nil {
+			b.BCaller = caller
+			delete(fields, zerolog.CallerFieldName)
+		}
+	}
+
+	if msg, ok := fields[zerolog.ErrorFieldName].(string); ok {
+		b.BError = &event.Error{Message: &msg}
+	}
+
+	ts, ok := parseTime(fields[zerolog.TimestampFieldName])
+	if ok {
+		delete(fields, zerolog.TimestampFieldName)
+	}
+
+	if len(fields) > 0 {
+		b.Metadata(numbers(fields).(map[string]interface{}))
+	}
+
+	m := b.Build()
+
+	if ok {
+		m.Time = timestamppb.New(ts)
+	}
+
+	if _, err = w.logger.Output(m); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// numbers function converts the json.Number values in the input (decoded) JSON value into
+// int64 values, or float64 values if they are not integers
+func numbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+
+		f, _ := t.Float64()
+		return f
+	case map[string]interface{}:
+		for k, inner := range t {
+			t[k] = numbers(inner)
+		}
+		return t
+	case []interface{}:
+		for idx, inner := range t {
+			t[idx] = numbers(inner)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+// parseTime function reads the input timestamp field according to zerolog.TimeFieldFormat
+func parseTime(v interface{}) (time.Time, bool) {
+	switch t := v.(type) {
+	case string:
+		ts, err := time.Parse(zerolog.TimeFieldFormat, t)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return ts, true
+	case json.Number:
+		i, err := t.Int64()
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		switch zerolog.TimeFieldFormat {
+		case zerolog.TimeFormatUnix:
+			return time.Unix(i, 0), true
+		case zerolog.TimeFormatUnixMs:
+			return time.UnixMilli(i), true
+		case zerolog.TimeFormatUnixMicro:
+			return time.UnixMicro(i), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseCaller function reads a caller field formatted as `file:line`
+func parseCaller(s string) *event.Caller {
+	idx := strings.LastIndexByte(s, ':')
+	if idx < 0 {
+		return nil
+	}
+
+	line, err := strconv.Atoi(s[idx+1:])
+	if err != nil {
+		return nil
+	}
+
+	return event.NewCallerFrom(s[:idx], line, "")
+}
@@ -0,0 +1,200 @@
+package zzerolog
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/zalgonoise/zlog/log"
+	"github.com/zalgonoise/zlog/log/event"
+)
+
+// capture function creates a Logger which stores the events it writes in the returned slice
+func capture(confs ...log.LoggerConfig) (log.Logger, *[]*event.Event) {
+	var events []*event.Event
+
+	confs = append(confs,
+		log.WithOut(new(bytes.Buffer)),
+		log.SkipExit,
+		log.WithHook(func(m *event.Event) bool {
+			events = append(events, m)
+			return true
+		}),
+	)
+
+	return log.New(confs...), &events
+}
+
+func TestLevel(t *testing.T) {
+	module := "zzerolog"
+	funcname := "Level()"
+
+	type test struct {
+		level zerolog.Level
+		wants event.Level
+	}
+
+	var tests = []test{
+		{level: zerolog.TraceLevel, wants: event.Level_trace},
+		{level: zerolog.DebugLevel, wants: event.Level_debug},
+		{level: zerolog.InfoLevel, wants: event.Level_info},
+		{level: zerolog.WarnLevel, wants: event.Level_warn},
+		{level: zerolog.ErrorLevel, wants: event.Level_error},
+		{level: zerolog.FatalLevel, wants: event.Level_fatal},
+		{level: zerolog.PanicLevel, wants: event.Level_panic},
+		{level: zerolog.NoLevel, wants: event.Level_info},
+	}
+
+	for idx, test := range tests {
+		if level := Level(test.level); level != test.wants {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] level mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.wants, level, test.level)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.level)
+	}
+}
+
+func TestWriteLevel(t *testing.T) {
+	module := "Writer"
+	funcname := "WriteLevel()"
+
+	ts := time.Date(2022, 8, 1, 10, 30, 0, 0, time.UTC)
+
+	type test struct {
+		name  string
+		log   func(l zerolog.Logger)
+		level event.Level
+		msg   string
+		meta  map[string]interface{}
+		err   bool
+		time  bool
+	}
+
+	var tests = []test{
+		{
+			name:  "simple event",
+			log:   func(l zerolog.Logger) { l.Warn().Bool("a", true).Msg("null") },
+			level: event.Level_warn,
+			msg:   "null",
+			meta:  map[string]interface{}{"a": true},
+		},
+		{
+			name: "lossless integers and nested objects",
+			log: func(l zerolog.Logger) {
+				l.Info().Int64("id", 1<<62+1).Dict("obj", zerolog.Dict().Float64("f", 1.5)).Msg("null")
+			},
+			level: event.Level_info,
+			msg:   "null",
+			meta:  map[string]interface{}{"id": int64(1<<62 + 1), "obj": map[string]interface{}{"f": 1.5}},
+		},
+		{
+			name:  "event with a timestamp",
+			log:   func(l zerolog.Logger) { l.Info().Time(zerolog.TimestampFieldName, ts).Msg("null") },
+			level: event.Level_info,
+			msg:   "null",
+			time:  true,
+		},
+		{
+			name:  "event with an error",
+			log:   func(l zerolog.Logger) { l.Error().Err(errors.New("not found")).Msg("failed") },
+			level: event.Level_error,
+			msg:   "failed",
+			meta:  map[string]interface{}{"error": "not found"},
+			err:   true,
+		},
+		{
+			name:  "event without a level",
+			log:   func(l zerolog.Logger) { l.Log().Msg("null") },
+			level: event.Level_info,
+			msg:   "null",
+		},
+	}
+
+	for idx, test := range tests {
+		logger, events := capture()
+
+		test.log(zerolog.New(NewWriter(logger)))
+
+		if len(*events) != 1 {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected one event; got %v -- action: %s", idx, module, funcname, len(*events), test.name)
+			continue
+		}
+
+		m := (*events)[0]
+
+		if m.GetLevel() != test.level || m.GetMsg() != test.msg {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] event mismatch: wanted [%s] %s ; got [%s] %s -- action: %s", idx, module, funcname, test.level, test.msg, m.GetLevel(), m.GetMsg(), test.name)
+			continue
+		}
+
+		if len(test.meta) > 0 && !reflect.DeepEqual(m.Metadata(), test.meta) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] metadata mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.meta, m.Metadata(), test.name)
+			continue
+		}
+
+		if (m.GetError() != nil) != test.err {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.err, m.GetError(), test.name)
+			continue
+		}
+
+		if test.time && !m.GetTime().AsTime().Equal(ts) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] timestamp mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, ts, m.GetTime().AsTime(), test.name)
+			continue
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	module := "Writer"
+	funcname := "Write()"
+
+	logger, events := capture()
+	w := NewWriter(logger)
+
+	if _, err := w.Write([]byte(`not json`)); err != ErrInvalidObject {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] error mismatch: wanted %v ; got %v -- action: %s", 0, module, funcname, ErrInvalidObject, err, "invalid input")
+		return
+	}
+
+	if _, err := w.Write([]byte(`{"level":"debug","message":"null"}`)); err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 1, module, funcname, err, "read the level field")
+		return
+	}
+
+	if len(*events) != 1 || (*events)[0].GetLevel() != event.Level_debug {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a single debug event; got %v -- action: %s", 1, module, funcname, *events, "read the level field")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "read the level field")
+}
+
+func TestCaller(t *testing.T) {
+	module := "zzerolog"
+	funcname := "WriteLevel()"
+
+	logger, events := capture(log.WithCaller())
+	z := zerolog.New(NewWriter(logger))
+
+	_, _, line, _ := runtime.Caller(0)
+	z.Info().Msg("null")
+
+	if len(*events) != 1 {
+		t.Fatalf("#%v -- FAILED -- [%s] [%s] expected a single event; got %v -- action: %s", 0, module, funcname, len(*events), "skip the zerolog and adapter frames")
+	}
+
+	if c := (*events)[0].GetCaller(); !strings.HasSuffix(c.GetFile(), "writer_test.go") || c.GetLine() != int32(line+1) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] location mismatch: wanted writer_test.go:%v ; got %s -- action: %s", 0, module, funcname, line+1, c.Short(), "skip the zerolog and adapter frames")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "skip the zerolog and adapter frames")
+}
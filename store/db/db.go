@@ -4,50 +4,76 @@ import (
 	"errors"
 	"fmt"
 	"io"
+
+	"github.com/zalgonoise/zlog/store"
 )
 
 var (
 	ErrShortWrite = errors.New("short write")
 )
 
+// WriteError type is a failed write to one of a MultiWriteCloser's writers, identified by its
+// index and type (see store.WriteError). A Logger writing to a MultiWriteCloser receives these
+// errors in its error handler, while the event is still written to the remaining writers
+type WriteError = store.WriteError
+
 type multiWriteCloser struct {
 	writers []io.WriteCloser
+	closed  bool
 }
 
 // Write method is a wraper for io.Writer, which calls this method across all
 // WriteClosers.
 //
-// Writes are sequential. If an error is retrieved from a write, the remainder of the
-// operation is cancelled, returning the error encountered.
+// Writes are sequential. If an error is retrieved from a write, the remaining writers
+// are still written to, and the errors encountered are returned as a chain of WriteErrors,
+// identifying the writers that failed.
+//
+// Once the multiWriteCloser is closed, it returns store.ErrClosed
 func (m *multiWriteCloser) Write(p []byte) (n int, err error) {
-	var errs []error
+	if m.closed {
+		return 0, store.ErrClosed
+	}
 
-	for _, w := range m.writers {
+	var errs []*WriteError
+
+	for idx, w := range m.writers {
 		n, err = w.Write(p)
-		if err != nil {
-			errs = append(errs, err)
-			continue
+
+		if err == nil && n != len(p) {
+			err = ErrShortWrite
 		}
-		if n != len(p) {
-			errs = append(errs, ErrShortWrite)
-			continue
+
+		if err != nil {
+			errs = append(errs, store.NewWriteError(idx, w, err))
 		}
 	}
-	return len(p), wrapErrors(errs)
+
+	return len(p), store.JoinWriteErrors(errs...)
 }
 
 // Close method is a wrapper for io.Closer, which calls this method across all
 // WriteClosers.
 //
-// It only returns an error as per the io.Closer signature. However, unlike Write(),
-// the operation will not halt if errors are retrieved when closing a Writer.
+// Like Write(), the operation does not halt if errors are retrieved when closing a Writer:
+// all writers are closed, and the errors are collected and returned as one, if any. If
+// there is only one error, the original error is returned. If there are multiple errors,
+// the first one is wrapped (matching errors.Is() and errors.As()) and the messages of the
+// remaining are appended to it:
+//
+//     "{first error} ; {second error} ; {...}"
 //
-// Instead, the errors are collected and returned as one, if any. If there is only
-// one error, the original error is returned. If there are multiple errors, a single
-// error is returned, encapsulating all errors:
+// Unlike the write errors, which a Logger passes to its error handler and fallback writer
+// along with the failed event, these errors are only returned to the caller of Close().
 //
-//     "multiple errors when closing writers: {errors...}"
+// Closing a multiWriteCloser more than once is a no-op
 func (m *multiWriteCloser) Close() error {
+	if m.closed {
+		return nil
+	}
+
+	m.closed = true
+
 	var errs []error
 
 	for _, w := range m.writers {
@@ -60,6 +86,26 @@ func (m *multiWriteCloser) Close() error {
 	return wrapErrors(errs)
 }
 
+// Sync method calls the `Sync() error` method of all WriteClosers which implement it (such
+// as logfiles), collecting the errors in the same way as Close()
+func (m *multiWriteCloser) Sync() error {
+	if m.closed {
+		return store.ErrClosed
+	}
+
+	var errs []error
+
+	for _, w := range m.writers {
+		if s, ok := w.(interface{ Sync() error }); ok {
+			if err := s.Sync(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return wrapErrors(errs)
+}
+
 func wrapErrors(errs []error) error {
 	if len(errs) > 0 {
 		if len(errs) == 1 {
@@ -89,10 +135,10 @@ func wrapErrors(errs []error) error {
 // which can commit to the same Close call across all writers
 //
 // Each write is written to each listed writer, one at a time.
-// If a listed writer returns an error, that overall write operation
-// stops and returns the error; it does not continue down the list. This does not happen
-// with the Close() call, which is intended to be sent to all writers regardless of errors
-// retrieved. It will return a single error encapsulating all errors if existing
+// If a listed writer returns an error, the write continues down the list, returning the
+// errors as a chain of WriteErrors. The same happens with the Close() call, which is
+// intended to be sent to all writers regardless of errors retrieved. It will return a
+// single error encapsulating all errors if existing
 func MultiWriteCloser(wc ...io.WriteCloser) io.WriteCloser {
 	if len(wc) == 0 {
 		return nil
@@ -125,5 +171,5 @@ func MultiWriteCloser(wc ...io.WriteCloser) io.WriteCloser {
 		return allWriters[0]
 	}
 
-	return &multiWriteCloser{allWriters}
+	return &multiWriteCloser{writers: allWriters}
 }
@@ -5,6 +5,8 @@ import (
 	"io"
 	"reflect"
 	"testing"
+
+	"github.com/zalgonoise/zlog/store"
 )
 
 type writeCloseBuffer struct {
@@ -557,3 +559,98 @@ func TestMultiWriteCloserClose(t *testing.T) {
 		cleanup(test)
 	}
 }
+
+type syncWriteCloseBuffer struct {
+	writeCloseBuffer
+	synced bool
+}
+
+func (wcb *syncWriteCloseBuffer) Sync() error {
+	wcb.synced = true
+	return nil
+}
+
+func TestMultiWriteCloserSync(t *testing.T) {
+	module := "MultiWriteCloser"
+	funcname := "Sync()"
+
+	var (
+		plain  = &writeCloseBuffer{}
+		synced = &syncWriteCloseBuffer{}
+	)
+
+	w := MultiWriteCloser(plain, synced)
+
+	if err := w.(*multiWriteCloser).Sync(); err != nil || !synced.synced {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the writer to be synced; got %v -- action: %s", 0, module, funcname, err, "sync writers")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "sync writers")
+
+	for i := 0; i < 2; i++ {
+		if err := w.Close(); err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 1, module, funcname, err, "close writers")
+			return
+		}
+	}
+
+	if !plain.closed || !synced.closed {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected all writers to be closed -- action: %s", 1, module, funcname, "close writers")
+		return
+	}
+
+	if n, err := w.Write([]byte("null")); n != 0 || !errors.Is(err, store.ErrClosed) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v, %v -- action: %s", 2, module, funcname, n, err, "write after closing")
+		return
+	}
+
+	if err := w.(*multiWriteCloser).Sync(); !errors.Is(err, store.ErrClosed) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v -- action: %s", 2, module, funcname, err, "sync after closing")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "write after closing")
+}
+
+func TestWriteError(t *testing.T) {
+	module := "MultiWriteCloser"
+	funcname := "Write()"
+
+	var (
+		errFirst = errors.New("first")
+		errLast  = errors.New("last")
+		bufs     = []*writeCloseBuffer{{}, {}, {}}
+	)
+
+	bufs[0].setError(errFirst)
+	bufs[2].setError(errLast)
+
+	w := MultiWriteCloser(bufs[0], bufs[1], bufs[2])
+
+	n, err := w.Write([]byte("null"))
+
+	if n != 4 || string(bufs[1].buf) != "null" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the remaining writers to be written to; got %v, %q -- action: %s", 0, module, funcname, n, string(bufs[1].buf), "partial failure")
+		return
+	}
+
+	var werr *WriteError
+
+	if !errors.As(err, &werr) || werr.Index != 0 || werr.Err != errFirst {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected first WriteError: %v -- action: %s", 0, module, funcname, err, "partial failure")
+		return
+	}
+
+	if !errors.As(errors.Unwrap(err), &werr) || werr.Index != 2 || !errors.Is(err, errLast) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected second WriteError: %v -- action: %s", 0, module, funcname, err, "partial failure")
+		return
+	}
+
+	if err.Error() != "writer #0 (*db.writeCloseBuffer): first ; writer #2 (*db.writeCloseBuffer): last" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error message: %q -- action: %s", 0, module, funcname, err.Error(), "partial failure")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "partial failure")
+}
@@ -0,0 +1,146 @@
+package db
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/zlog/store"
+)
+
+type fanOutWriteCloser struct {
+	mu      sync.Mutex
+	writers []io.WriteCloser
+	fan     *store.FanOut
+	closed  bool
+}
+
+// FanOutWriteCloser function creates a WriteCloser that duplicates its writes to all the provided
+// writers in parallel, with one goroutine and queue per writer; unlike MultiWriteCloser, which
+// writes to them one at a time.
+//
+// Each write waits for the writers up to the input timeout (or until they are all done, if zero),
+// so that a slow database adds at most the timeout to each write instead of its full latency. A
+// writer that times out keeps its write queued, and a writer whose queue is full skips the write.
+// A failing writer never stops the write to the others, and the failures are returned as a chain
+// of WriteErrors, identifying the writers (and wrapping store.ErrTimeout or store.ErrQueueFull):
+//
+//	w := db.FanOutWriteCloser(200*time.Millisecond, mongoWriter, postgresWriter)
+//	defer w.Close()
+//
+//	logger := log.New(log.WithDatabase(w))
+//
+// Closing the WriteCloser waits for the queued writes before closing the writers, with the same
+// error handling as MultiWriteCloser
+func FanOutWriteCloser(timeout time.Duration, wc ...io.WriteCloser) io.WriteCloser {
+	writers := make([]io.WriteCloser, 0, len(wc))
+
+	for _, w := range wc {
+		if w != nil {
+			writers = append(writers, w)
+		}
+	}
+
+	switch len(writers) {
+	case 0:
+		return nil
+	case 1:
+		return writers[0]
+	}
+
+	return &fanOutWriteCloser{
+		writers: writers,
+		fan:     store.NewFanOut(len(writers), timeout),
+	}
+}
+
+// Write method is a wrapper for io.Writer, which writes a copy of the input buffer to all
+// WriteClosers in parallel.
+//
+// Once the fanOutWriteCloser is closed, it returns store.ErrClosed
+func (f *fanOutWriteCloser) Write(p []byte) (n int, err error) {
+	if f.isClosed() {
+		return 0, store.ErrClosed
+	}
+
+	buf := make([]byte, len(p))
+	copy(buf, p)
+
+	results := f.fan.Do(func(idx int) error {
+		n, err := f.writers[idx].Write(buf)
+
+		if err == nil && n != len(buf) {
+			return ErrShortWrite
+		}
+
+		return err
+	})
+
+	var errs []*WriteError
+
+	for idx, err := range results {
+		if err != nil {
+			errs = append(errs, store.NewWriteError(idx, f.writers[idx], err))
+		}
+	}
+
+	return len(p), store.JoinWriteErrors(errs...)
+}
+
+// Sync method waits for the queued writes, and then calls the `Sync() error` method of all
+// WriteClosers which implement it, collecting the errors as one
+func (f *fanOutWriteCloser) Sync() error {
+	if f.isClosed() {
+		return store.ErrClosed
+	}
+
+	_ = f.fan.Flush(context.Background()) // deliberately ignore error in this method call
+
+	var errs []error
+
+	for _, w := range f.writers {
+		if s, ok := w.(interface{ Sync() error }); ok {
+			if err := s.Sync(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return wrapErrors(errs)
+}
+
+// Close method stops the fanOutWriteCloser's goroutines once the queued writes are done, and then
+// closes all WriteClosers regardless of errors, collecting the errors as one.
+//
+// Closing a fanOutWriteCloser more than once is a no-op
+func (f *fanOutWriteCloser) Close() error {
+	f.mu.Lock()
+
+	if f.closed {
+		f.mu.Unlock()
+		return nil
+	}
+
+	f.closed = true
+	f.mu.Unlock()
+
+	f.fan.Close()
+
+	var errs []error
+
+	for _, w := range f.writers {
+		if err := w.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return wrapErrors(errs)
+}
+
+func (f *fanOutWriteCloser) isClosed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.closed
+}
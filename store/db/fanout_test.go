@@ -0,0 +1,128 @@
+package db
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/zlog/store"
+)
+
+type slowWriteCloser struct {
+	mu     sync.Mutex
+	buf    []byte
+	delay  time.Duration
+	err    error
+	closed bool
+}
+
+func (w *slowWriteCloser) Write(p []byte) (n int, err error) {
+	time.Sleep(w.delay)
+
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
+
+func (w *slowWriteCloser) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closed = true
+	return nil
+}
+
+func (w *slowWriteCloser) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return string(w.buf)
+}
+
+func TestFanOutWriteCloser(t *testing.T) {
+	module := "FanOutWriteCloser"
+	funcname := "Write()"
+
+	if FanOutWriteCloser(time.Second) != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a nil WriteCloser -- action: %s", 0, module, funcname, "no writers")
+		return
+	}
+
+	single := &slowWriteCloser{}
+
+	if w := FanOutWriteCloser(time.Second, nil, single); w != io.WriteCloser(single) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the single writer to be returned; got %v -- action: %s", 0, module, funcname, w, "single writer")
+		return
+	}
+
+	var (
+		errWrite = errors.New("failed to write")
+		writers  = []*slowWriteCloser{
+			{delay: 50 * time.Millisecond},
+			{delay: 50 * time.Millisecond, err: errWrite},
+			{delay: time.Second},
+			{delay: 50 * time.Millisecond},
+		}
+	)
+
+	w := FanOutWriteCloser(200*time.Millisecond, writers[0], writers[1], writers[2], writers[3])
+
+	start := time.Now()
+	n, err := w.Write([]byte("null"))
+
+	if elapsed := time.Since(start); n != 4 || elapsed > 500*time.Millisecond {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the writers to be written in parallel; wrote %v bytes in %v -- action: %s", 1, module, funcname, n, elapsed, "isolate failures")
+		return
+	}
+
+	var werr *WriteError
+
+	if !errors.As(err, &werr) || werr.Index != 1 || werr.Type != "*db.slowWriteCloser" || !errors.Is(err, errWrite) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected first error: %v -- action: %s", 1, module, funcname, err, "isolate failures")
+		return
+	}
+
+	if !errors.As(errors.Unwrap(err), &werr) || werr.Index != 2 || !errors.Is(werr, store.ErrTimeout) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected second error: %v -- action: %s", 1, module, funcname, err, "isolate failures")
+		return
+	}
+
+	if writers[0].String() != "null" || writers[3].String() != "null" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the remaining writers to be written to -- action: %s", 1, module, funcname, "isolate failures")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "isolate failures")
+
+	if err := w.Close(); err != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 2, module, funcname, err, "close writers")
+		return
+	}
+
+	for idx, wc := range writers {
+		if !wc.closed {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] writer #%v was not closed -- action: %s", 2, module, funcname, idx, "close writers")
+			return
+		}
+	}
+
+	if writers[2].String() != "null" {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected the queued write to complete before closing -- action: %s", 2, module, funcname, "close writers")
+		return
+	}
+
+	if _, err := w.Write([]byte("null")); !errors.Is(err, store.ErrClosed) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v -- action: %s", 2, module, funcname, err, "write after closing")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 2, module, funcname, "close writers")
+}
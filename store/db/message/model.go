@@ -26,6 +26,7 @@ type Event struct {
 	Level    string
 	Msg      string
 	Metadata string
+	Error    string
 }
 
 // From method will take in an event.Event and convert it into a (DB model) Event,
@@ -39,7 +40,9 @@ func (m *Event) From(msg *event.Event) error {
 	sub := msg.GetSub()
 	level := msg.GetLevel().String()
 	body := msg.GetMsg()
-	meta, _ := json.Marshal(msg.GetMeta().AsMap())
+	// encode the metadata with its typed data, so large integers and
+	// timestamps are kept as-is
+	meta, _ := json.Marshal(msg.Metadata())
 
 	// check defaults
 	if body == "" {
@@ -60,6 +63,13 @@ func (m *Event) From(msg *event.Event) error {
 		m.Metadata = metafmt
 	}
 
+	// store the structured error as JSON
+	if msg.GetError() != nil {
+		if errfmt, err := json.Marshal(msg.GetError()); err == nil {
+			m.Error = string(errfmt)
+		}
+	}
+
 	fmt.Println(m)
 	return nil
 }
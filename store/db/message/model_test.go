@@ -2,6 +2,7 @@ package model
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/zalgonoise/zlog/log/event"
@@ -45,6 +46,30 @@ func TestModelFrom(t *testing.T) {
 			},
 			ok: true,
 		},
+		{
+			name:  "default message w/ error",
+			event: event.New().Message("testing").Err(errors.New("null")).Build(),
+			model: &Event{
+				Prefix: "log",
+				Sub:    "",
+				Level:  "info",
+				Msg:    "testing",
+				Error:  `{"message":"null","type":"*errors.errorString"}`,
+			},
+			ok: true,
+		},
+		{
+			name:  "default message w/ lossless meta",
+			event: event.New().Message("testing").Metadata(event.Field{"id": int64(1<<62 + 1)}).Build(),
+			model: &Event{
+				Prefix:   "log",
+				Sub:      "",
+				Level:    "info",
+				Msg:      "testing",
+				Metadata: `{"id":4611686018427387905}`,
+			},
+			ok: true,
+		},
 		{
 			name:  "invalid message",
 			event: &event.Event{},
@@ -130,7 +155,7 @@ func TestModelFrom(t *testing.T) {
 			return
 		}
 
-		meta, err := json.Marshal(test.event.GetMeta().AsMap())
+		meta, err := json.Marshal(test.event.Metadata())
 		if err != nil {
 			t.Errorf(
 				"#%v -- FAILED -- [%s] [%s] -- error converting metadata to JSON: %v -- action: %s",
@@ -160,6 +185,32 @@ func TestModelFrom(t *testing.T) {
 			return
 		}
 
+		if test.model != nil && test.model.Metadata != "" && e.Metadata != test.model.Metadata {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] -- metadata mismatch: wanted %s, got %s -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.model.Metadata,
+				e.Metadata,
+				test.name,
+			)
+			return
+		}
+
+		if test.model != nil && e.Error != test.model.Error {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] -- error mismatch: wanted %s, got %s -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.model.Error,
+				e.Error,
+				test.name,
+			)
+			return
+		}
+
 		t.Logf(
 			"#%v -- PASSED -- [%s] [%s] -- action: %s",
 			idx,
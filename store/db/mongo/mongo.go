@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/zalgonoise/zlog/log"
@@ -101,7 +103,7 @@ func (d *Mongo) Create(msg ...*event.Event) error {
 			{Key: "module", Value: m.GetSub()},
 			{Key: "level", Value: m.GetLevel()},
 			{Key: "message", Value: m.GetMsg()},
-			{Key: "metadata", Value: m.Meta.AsMap()},
+			{Key: "metadata", Value: metadata(m)},
 		}
 		msgs = append(msgs, entry)
 	}
@@ -171,3 +173,17 @@ func WithMongo(addr, database, collection string) log.LoggerConfig {
 		Fmt: log.FormatProtobuf,
 	}
 }
+
+// metadata function returns the event's metadata with its typed data as native BSON values;
+// except for unsigned integers that overflow an int64, which are stored as decimal strings
+func metadata(m *event.Event) map[string]interface{} {
+	meta := m.Metadata()
+
+	for k, v := range meta {
+		if u, ok := v.(uint64); ok && u > math.MaxInt64 {
+			meta[k] = strconv.FormatUint(u, 10)
+		}
+	}
+
+	return meta
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"regexp"
 
@@ -905,3 +906,28 @@ func TestWithMongo(t *testing.T) {
 	}
 
 }
+
+func TestMetadata(t *testing.T) {
+	module := "Mongo"
+	funcname := "metadata()"
+
+	e := event.New().Message("null").Metadata(event.Field{
+		"id":    int64(1<<62 + 1),
+		"small": uint64(1),
+		"large": uint64(math.MaxUint64),
+	}).Build()
+
+	meta := metadata(e)
+
+	if meta["id"] != int64(1<<62+1) {
+		t.Errorf("[%s] [%s] int64 mismatch: got %v (%T)", module, funcname, meta["id"], meta["id"])
+	}
+
+	if meta["small"] != uint64(1) {
+		t.Errorf("[%s] [%s] uint64 mismatch: got %v (%T)", module, funcname, meta["small"], meta["small"])
+	}
+
+	if meta["large"] != "18446744073709551615" {
+		t.Errorf("[%s] [%s] expected overflowing uint64 as a string: got %v (%T)", module, funcname, meta["large"], meta["large"])
+	}
+}
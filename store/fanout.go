@@ -0,0 +1,299 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// fanOutQueueSize is the number of pending writes that each output of a FanOut can hold
+const fanOutQueueSize = 256
+
+var (
+	// ErrTimeout is set to the outputs of a FanOut which did not complete a write within its timeout.
+	// The write is not cancelled, and it is still performed in the output's goroutine
+	ErrTimeout error = errors.New("write timed out")
+
+	// ErrQueueFull is set to the outputs of a FanOut whose queue is full, in which case the write
+	// is dropped for that output
+	ErrQueueFull error = errors.New("write queue is full")
+)
+
+// WriteError struct describes a failed write to one of the outputs of a multi-writer (such as a
+// MultiWriter, a db.MultiWriteCloser or a FanOut), identified by its index and type. When several
+// outputs fail in the same write, their WriteErrors are chained in order, so that each of them is
+// reached with errors.Unwrap(); while errors.Is() and errors.As() match the outputs' own errors:
+//
+//	var werr *store.WriteError
+//
+//	if errors.As(err, &werr) {
+//	    // werr.Index is the first output that failed, and werr.Type its type
+//	}
+type WriteError struct {
+	Index int
+	Type  string
+	Err   error
+	next  *WriteError
+}
+
+// NewWriteError function creates a WriteError for the output with index `idx`, naming its type
+func NewWriteError(idx int, output interface{}, err error) *WriteError {
+	return &WriteError{
+		Index: idx,
+		Type:  fmt.Sprintf("%T", output),
+		Err:   err,
+	}
+}
+
+// JoinWriteErrors function chains the input (non-nil) WriteErrors in order, returning the first
+// one; or nil if there are none
+func JoinWriteErrors(errs ...*WriteError) error {
+	var first, last *WriteError
+
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if first == nil {
+			first = err
+		} else {
+			last.next = err
+		}
+
+		last = err
+	}
+
+	if first == nil {
+		return nil
+	}
+
+	return first
+}
+
+// Error method implements the error interface
+func (e *WriteError) Error() string {
+	var msg = fmt.Sprintf("writer #%v (%s): %v", e.Index, e.Type, e.Err)
+
+	if e.next == nil {
+		return msg
+	}
+
+	return msg + " ; " + e.next.Error()
+}
+
+// Unwrap method returns the WriteError of the next output that failed, if any
+func (e *WriteError) Unwrap() error {
+	if e.next == nil {
+		return nil
+	}
+
+	return e.next
+}
+
+// Is method returns true if the output's error matches the input error
+func (e *WriteError) Is(target error) bool {
+	return errors.Is(e.Err, target)
+}
+
+// As method returns true if the output's error can be assigned to the input target
+func (e *WriteError) As(target interface{}) bool {
+	return errors.As(e.Err, target)
+}
+
+// FanOut struct runs the writes to a set of outputs in parallel, with one goroutine and queue per
+// output; so that a slow output does not add its latency to the writes to the others, and a failing
+// one does not prevent them.
+//
+// It is the engine for fan-out writers like log.FanOutLogger() and db.FanOutWriteCloser(), which
+// hold the outputs themselves and reference them by index
+type FanOut struct {
+	mu      sync.RWMutex
+	wg      sync.WaitGroup
+	queues  []chan func()
+	timeout time.Duration
+	closed  bool
+
+	// stop is closed once the FanOut is closed, and exited once its goroutines are done
+	stop   chan struct{}
+	exited chan struct{}
+}
+
+// fanOutResult struct is the outcome of a write to a single output
+type fanOutResult struct {
+	idx int
+	err error
+}
+
+// NewFanOut function creates a FanOut for `n` outputs, starting their goroutines. Each write waits
+// for the outputs up to the input timeout, or until they are all done if it is zero or less.
+//
+// A FanOut must be closed (with its Close() method) to stop its goroutines
+func NewFanOut(n int, timeout time.Duration) *FanOut {
+	f := &FanOut{
+		queues:  make([]chan func(), n),
+		timeout: timeout,
+		stop:    make(chan struct{}),
+		exited:  make(chan struct{}),
+	}
+
+	for idx := range f.queues {
+		f.queues[idx] = make(chan func(), fanOutQueueSize)
+		f.wg.Add(1)
+
+		go f.run(f.queues[idx])
+	}
+
+	return f
+}
+
+// run method calls the functions in the input queue until the FanOut is closed, and then calls the
+// ones which are still queued before returning
+func (f *FanOut) run(queue chan func()) {
+	defer f.wg.Done()
+
+	for {
+		select {
+		case fn := <-queue:
+			fn()
+		case <-f.stop:
+			for {
+				select {
+				case fn := <-queue:
+					fn()
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Do method queues the input function once for each output (with its index), and waits for them
+// to complete -- returning the errors by output index.
+//
+// An output whose queue is full is skipped with ErrQueueFull, and an output that does not complete
+// the function within the FanOut's timeout is set with ErrTimeout (while the function still runs
+// in its goroutine, later on). Once the FanOut is closed, all outputs return ErrClosed
+func (f *FanOut) Do(fn func(idx int) error) []error {
+	var (
+		errs    = make([]error, len(f.queues))
+		done    = make([]bool, len(f.queues))
+		results = make(chan fanOutResult, len(f.queues))
+		pending int
+	)
+
+	f.mu.RLock()
+
+	for idx, queue := range f.queues {
+		if f.closed {
+			errs[idx] = ErrClosed
+			continue
+		}
+
+		idx := idx
+
+		select {
+		case queue <- func() { results <- fanOutResult{idx: idx, err: fn(idx)} }:
+			pending++
+		default:
+			errs[idx] = ErrQueueFull
+		}
+	}
+
+	f.mu.RUnlock()
+
+	var timeout <-chan time.Time
+
+	if f.timeout > 0 && pending > 0 {
+		timer := time.NewTimer(f.timeout)
+		defer timer.Stop()
+
+		timeout = timer.C
+	}
+
+	for pending > 0 {
+		select {
+		case r := <-results:
+			errs[r.idx] = r.err
+			done[r.idx] = true
+			pending--
+		case <-timeout:
+			for idx := range errs {
+				if !done[idx] && errs[idx] == nil {
+					errs[idx] = ErrTimeout
+				}
+			}
+
+			return errs
+		}
+	}
+
+	return errs
+}
+
+// Flush method blocks until the functions queued before the call are complete in all outputs, or
+// until the input context is done (returning its error). Waiting on a full queue does not block
+// Close(), and flushing a closed FanOut is a no-op, as closing it already waits for its queues
+func (f *FanOut) Flush(ctx context.Context) error {
+	f.mu.RLock()
+
+	if f.closed {
+		f.mu.RUnlock()
+		return nil
+	}
+
+	queues := f.queues
+	f.mu.RUnlock()
+
+	var (
+		done = make(chan struct{}, len(queues))
+		sent int
+	)
+
+	for _, queue := range queues {
+		select {
+		case queue <- func() { done <- struct{}{} }:
+			sent++
+		case <-f.stop:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	for ; sent > 0; sent-- {
+		select {
+		case <-done:
+		case <-f.exited:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Close method stops the FanOut's goroutines, once the functions queued before the call are
+// complete. Closing a FanOut more than once is a no-op
+func (f *FanOut) Close() {
+	f.mu.Lock()
+
+	first := !f.closed
+
+	if first {
+		f.closed = true
+		close(f.stop)
+	}
+
+	f.mu.Unlock()
+
+	f.wg.Wait()
+
+	if first {
+		close(f.exited)
+	}
+}
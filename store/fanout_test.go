@@ -0,0 +1,230 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFanOutDo(t *testing.T) {
+	module := "FanOut"
+	funcname := "Do()"
+
+	type test struct {
+		name    string
+		timeout time.Duration
+		delays  []time.Duration
+		errs    []error
+		wants   []error
+		maxTime time.Duration
+	}
+
+	var errWrite = errors.New("failed to write")
+
+	var tests = []test{
+		{
+			name:    "parallel writes",
+			delays:  []time.Duration{50 * time.Millisecond, 50 * time.Millisecond, 50 * time.Millisecond},
+			errs:    []error{nil, nil, nil},
+			wants:   []error{nil, nil, nil},
+			maxTime: 140 * time.Millisecond,
+		},
+		{
+			name:    "failing output",
+			delays:  []time.Duration{0, 0, 0},
+			errs:    []error{nil, errWrite, nil},
+			wants:   []error{nil, errWrite, nil},
+			maxTime: time.Second,
+		},
+		{
+			name:    "slow output",
+			timeout: 20 * time.Millisecond,
+			delays:  []time.Duration{0, time.Second, 0},
+			errs:    []error{nil, nil, nil},
+			wants:   []error{nil, ErrTimeout, nil},
+			maxTime: 500 * time.Millisecond,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		f := NewFanOut(len(test.delays), test.timeout)
+
+		start := time.Now()
+
+		errs := f.Do(func(i int) error {
+			time.Sleep(test.delays[i])
+			return test.errs[i]
+		})
+
+		if elapsed := time.Since(start); elapsed > test.maxTime {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected the outputs to be written in parallel; took %v -- action: %s", idx, module, funcname, elapsed, test.name)
+			return
+		}
+
+		for i := range test.wants {
+			if !errors.Is(errs[i], test.wants[i]) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] output #%v error mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, i, test.wants[i], errs[i], test.name)
+				return
+			}
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFanOutQueue(t *testing.T) {
+	module := "FanOut"
+	funcname := "Do()"
+
+	var (
+		written int32
+		release = make(chan struct{})
+	)
+
+	f := NewFanOut(1, time.Millisecond)
+
+	// block the output, and fill its queue
+	for i := 0; i <= fanOutQueueSize; i++ {
+		_ = f.Do(func(int) error {
+			<-release
+			atomic.AddInt32(&written, 1)
+			return nil
+		})
+	}
+
+	if errs := f.Do(func(int) error { return nil }); !errors.Is(errs[0], ErrQueueFull) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrQueueFull; got %v -- action: %s", 0, module, funcname, errs[0], "full queue")
+		return
+	}
+
+	close(release)
+	_ = f.Flush(context.Background())
+
+	if n := atomic.LoadInt32(&written); n != fanOutQueueSize+1 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v queued writes; got %v -- action: %s", 1, module, funcname, fanOutQueueSize+1, n, "flush the queue")
+		return
+	}
+
+	f.Close()
+	f.Close()
+
+	if errs := f.Do(func(int) error { return nil }); !errors.Is(errs[0], ErrClosed) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected ErrClosed; got %v -- action: %s", 2, module, funcname, errs[0], "write after closing")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "full queue")
+}
+
+func TestFanOutFlush(t *testing.T) {
+	module := "FanOut"
+	funcname := "Flush()"
+
+	release := make(chan struct{})
+
+	f := NewFanOut(1, time.Millisecond)
+
+	// block the output, and fill its queue
+	for i := 0; i <= fanOutQueueSize; i++ {
+		_ = f.Do(func(int) error {
+			<-release
+			return nil
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := f.Flush(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a deadline exceeded error; got %v -- action: %s", 0, module, funcname, err, "give up on a full queue")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 0, module, funcname, "give up on a full queue")
+
+	var (
+		flushed = make(chan error)
+		closed  = make(chan struct{})
+	)
+
+	go func() { flushed <- f.Flush(context.Background()) }()
+
+	time.Sleep(10 * time.Millisecond)
+
+	go func() {
+		f.Close()
+		close(closed)
+	}()
+
+	// Close() marks the FanOut as closed while the Flush() call is still waiting on the full queue
+	deadline := time.Now().Add(time.Second)
+
+	for {
+		errs := f.Do(func(int) error { return nil })
+
+		if errors.Is(errs[0], ErrClosed) {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected the FanOut to be closed while flushing -- action: %s", 1, module, funcname, "do not block Close()")
+			close(release)
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	close(release)
+
+	select {
+	case err := <-flushed:
+		if err != nil {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", 1, module, funcname, err, "do not block Close()")
+			return
+		}
+	case <-time.After(time.Second):
+		t.Errorf("#%v -- FAILED -- [%s] [%s] flush still blocked after closing -- action: %s", 1, module, funcname, "do not block Close()")
+		return
+	}
+
+	<-closed
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "do not block Close()")
+}
+
+func TestJoinWriteErrors(t *testing.T) {
+	module := "WriteError"
+	funcname := "JoinWriteErrors()"
+
+	if JoinWriteErrors() != nil || JoinWriteErrors(nil) != nil {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] expected a nil error -- action: %s", 0, module, funcname, "no errors")
+		return
+	}
+
+	err := JoinWriteErrors(
+		NewWriteError(0, nilWritter{}, ErrTimeout),
+		nil,
+		NewWriteError(2, nilWritter{}, ErrQueueFull),
+	)
+
+	if wants := "writer #0 (store.nilWritter): write timed out ; writer #2 (store.nilWritter): write queue is full"; err.Error() != wants {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] message mismatch: wanted %q ; got %q -- action: %s", 1, module, funcname, wants, err.Error(), "chained errors")
+		return
+	}
+
+	var werr *WriteError
+
+	if !errors.Is(err, ErrQueueFull) || !errors.As(errors.Unwrap(err), &werr) || werr.Index != 2 {
+		t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error chain: %v -- action: %s", 1, module, funcname, err, "chained errors")
+		return
+	}
+
+	t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", 1, module, funcname, "chained errors")
+}
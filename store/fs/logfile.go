@@ -5,6 +5,8 @@ import (
 	"os"
 	"strings"
 	"time"
+
+	"github.com/zalgonoise/zlog/store"
 )
 
 const (
@@ -20,6 +22,7 @@ type Logfile struct {
 	file   *os.File
 	size   int64
 	rotate int
+	closed bool
 }
 
 // NewLogFile function use the target file as a Logfile, as indicated in the path string;
@@ -154,7 +157,13 @@ func (f *Logfile) IsTooHeavy() bool {
 
 // Rotate method will rename the existing (overweight) logfile to append a timestamp, and create
 // a new Logfile based on the original filename. The new file will be the target of subsequent writes.
+//
+// It returns store.ErrClosed if the Logfile is closed
 func (f *Logfile) Rotate() error {
+	if f.closed {
+		return store.ErrClosed
+	}
+
 	if f.IsTooHeavy() {
 		err := f.move(f.path)
 		if err != nil {
@@ -170,8 +179,14 @@ func (f *Logfile) Rotate() error {
 }
 
 // Write method is defined to implement the io.Writer interface, for Logfile to be compatible with Logger
-// as an output to be used
+// as an output to be used.
+//
+// Once the Logfile is closed, it returns store.ErrClosed
 func (f *Logfile) Write(b []byte) (n int, err error) {
+	if f.closed {
+		return 0, store.ErrClosed
+	}
+
 	// write first
 	n, err = f.file.Write(b)
 
@@ -190,3 +205,26 @@ func (f *Logfile) Write(b []byte) (n int, err error) {
 	return
 
 }
+
+// Sync method is a wrapper for an os.File.Sync(), committing the Logfile's contents to disk
+func (f *Logfile) Sync() error {
+	if f.closed {
+		return store.ErrClosed
+	}
+
+	return f.file.Sync()
+}
+
+// Close method implements the io.Closer interface, closing the Logfile's underlying os.File.
+//
+// Any further writes to the Logfile will return store.ErrClosed, and closing it more than once
+// is a no-op
+func (f *Logfile) Close() error {
+	if f.closed {
+		return nil
+	}
+
+	f.closed = true
+
+	return f.file.Close()
+}
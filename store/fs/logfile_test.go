@@ -7,6 +7,8 @@ import (
 	"os"
 	"regexp"
 	"testing"
+
+	"github.com/zalgonoise/zlog/store"
 )
 
 var mockByteChunk64 = []byte(
@@ -1095,3 +1097,81 @@ func TestLogfileWrite(t *testing.T) {
 
 	}
 }
+
+func TestLogfileClose(t *testing.T) {
+	path := t.TempDir() + "/test-close.log"
+
+	f, err := New(path)
+	if err != nil {
+		t.Errorf(
+			"#%v -- FAILED -- [Logfile] Logfile.Close() -- failed to create Logfile: %s",
+			0,
+			err,
+		)
+		return
+	}
+
+	if _, err := f.Write(mockByteChunk64); err != nil {
+		t.Errorf(
+			"#%v -- FAILED -- [Logfile] Logfile.Close() -- writting to Logfile failed with an error: %s",
+			0,
+			err,
+		)
+		return
+	}
+
+	if err := f.Sync(); err != nil {
+		t.Errorf(
+			"#%v -- FAILED -- [Logfile] Logfile.Sync() -- syncing the Logfile failed with an error: %s",
+			0,
+			err,
+		)
+		return
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := f.Close(); err != nil {
+			t.Errorf(
+				"#%v -- FAILED -- [Logfile] Logfile.Close() -- closing the Logfile failed with an error: %s",
+				1,
+				err,
+			)
+			return
+		}
+	}
+
+	if n, err := f.Write(mockByteChunk64); n != 0 || !errors.Is(err, store.ErrClosed) {
+		t.Errorf(
+			"#%v -- FAILED -- [Logfile] Logfile.Close() -- expected ErrClosed after closing; got %v, %v",
+			2,
+			n,
+			err,
+		)
+		return
+	}
+
+	if err := f.Sync(); !errors.Is(err, store.ErrClosed) {
+		t.Errorf(
+			"#%v -- FAILED -- [Logfile] Logfile.Sync() -- expected ErrClosed after closing; got %v",
+			2,
+			err,
+		)
+		return
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil || !bytes.Equal(b, mockByteChunk64) {
+		t.Errorf(
+			"#%v -- FAILED -- [Logfile] Logfile.Close() -- unexpected file contents: %q, %v",
+			3,
+			string(b),
+			err,
+		)
+		return
+	}
+
+	t.Logf(
+		"#%v -- PASSED -- [Logfile] Logfile.Close()",
+		0,
+	)
+}
@@ -0,0 +1,61 @@
+package store
+
+import "io"
+
+type multiWriter struct {
+	writers []io.Writer
+}
+
+// MultiWriter function creates an io.Writer that duplicates its writes to all the input io.Writers,
+// similar to io.MultiWriter(); except that a failing io.Writer does not stop the write to the
+// remaining ones.
+//
+// The writes are sequential, and any failed writes (or short writes, as io.ErrShortWrite) are
+// returned as a chain of WriteErrors, identifying the io.Writers that failed. MultiWriters in the
+// input are flattened into the new one
+func MultiWriter(writers ...io.Writer) io.Writer {
+	all := make([]io.Writer, 0, len(writers))
+
+	for _, w := range writers {
+		if mw, ok := w.(*multiWriter); ok {
+			all = append(all, mw.writers...)
+			continue
+		}
+
+		all = append(all, w)
+	}
+
+	return &multiWriter{
+		writers: all,
+	}
+}
+
+// Write method implements the io.Writer interface. It returns the length of the input buffer unless
+// all io.Writers fail
+func (m *multiWriter) Write(p []byte) (n int, err error) {
+	var errs []*WriteError
+
+	for idx, w := range m.writers {
+		n, err := w.Write(p)
+
+		if err == nil && n != len(p) {
+			err = io.ErrShortWrite
+		}
+
+		if err != nil {
+			errs = append(errs, NewWriteError(idx, w, err))
+		}
+	}
+
+	if len(errs) > 0 && len(errs) == len(m.writers) {
+		return 0, JoinWriteErrors(errs...)
+	}
+
+	return len(p), JoinWriteErrors(errs...)
+}
+
+// Writers method returns the io.Writers that the MultiWriter duplicates its writes to, so that
+// its owner can sync or close them individually
+func (m *multiWriter) Writers() []io.Writer {
+	return m.writers
+}
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+type testFailingWriter struct {
+	err error
+}
+
+func (w testFailingWriter) Write(p []byte) (n int, err error) {
+	return 0, w.err
+}
+
+func TestMultiWriter(t *testing.T) {
+	module := "MultiWriter"
+	funcname := "Write()"
+
+	type test struct {
+		name    string
+		writers func(bufs []*bytes.Buffer) []io.Writer
+		n       int
+		indexes []int
+		written int
+	}
+
+	var errWrite = errors.New("failed to write")
+
+	var tests = []test{
+		{
+			name: "write to all writers",
+			writers: func(bufs []*bytes.Buffer) []io.Writer {
+				return []io.Writer{bufs[0], bufs[1]}
+			},
+			n:       4,
+			written: 2,
+		},
+		{
+			name: "failing writer in between",
+			writers: func(bufs []*bytes.Buffer) []io.Writer {
+				return []io.Writer{bufs[0], testFailingWriter{errWrite}, bufs[1]}
+			},
+			n:       4,
+			indexes: []int{1},
+			written: 2,
+		},
+		{
+			name: "all writers failing",
+			writers: func(bufs []*bytes.Buffer) []io.Writer {
+				return []io.Writer{testFailingWriter{errWrite}, testFailingWriter{errWrite}}
+			},
+			n:       0,
+			indexes: []int{0, 1},
+		},
+		{
+			name: "nested MultiWriter",
+			writers: func(bufs []*bytes.Buffer) []io.Writer {
+				return []io.Writer{MultiWriter(bufs[0], testFailingWriter{errWrite}), bufs[1]}
+			},
+			n:       4,
+			indexes: []int{1},
+			written: 2,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		bufs := []*bytes.Buffer{{}, {}}
+
+		n, err := MultiWriter(test.writers(bufs)...).Write([]byte("null"))
+
+		if n != test.n {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] written byte length mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.n, n, test.name)
+			return
+		}
+
+		var indexes []int
+
+		for e := err; e != nil; e = errors.Unwrap(e) {
+			var werr *WriteError
+
+			if !errors.As(e, &werr) || !errors.Is(werr, errWrite) {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] unexpected error: %v -- action: %s", idx, module, funcname, e, test.name)
+				return
+			}
+
+			indexes = append(indexes, werr.Index)
+		}
+
+		if len(indexes) != len(test.indexes) {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] failed writers mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.indexes, indexes, test.name)
+			return
+		}
+
+		for i := range indexes {
+			if indexes[i] != test.indexes[i] {
+				t.Errorf("#%v -- FAILED -- [%s] [%s] failed writers mismatch: wanted %v ; got %v -- action: %s", idx, module, funcname, test.indexes, indexes, test.name)
+				return
+			}
+		}
+
+		var written int
+
+		for _, b := range bufs {
+			if b.String() == "null" {
+				written++
+			}
+		}
+
+		if written != test.written {
+			t.Errorf("#%v -- FAILED -- [%s] [%s] expected %v writers to be written to; got %v -- action: %s", idx, module, funcname, test.written, written, test.name)
+			return
+		}
+
+		t.Logf("#%v -- PASSED -- [%s] [%s] -- action: %s", idx, module, funcname, test.name)
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
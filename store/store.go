@@ -0,0 +1,11 @@
+// Package store contains the writers that Loggers can use as outputs, such as logfiles (in
+// the fs package) and databases (in the db package); and the errors shared across them.
+package store
+
+import "errors"
+
+var (
+	// ErrClosed is returned by the writers in this package when they are written to (or
+	// otherwise used) after being closed
+	ErrClosed error = errors.New("writer is closed")
+)